@@ -0,0 +1,120 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// session context variables set by the driver on connect.
+const (
+	svApplication     = "APPLICATION"
+	svApplicationUser = "APPLICATIONUSER"
+)
+
+// quoteLiteral returns s as sql string literal.
+func quoteLiteral(s string) string { return "'" + strings.ReplaceAll(s, "'", "''") + "'" }
+
+func rawConn(sqlConn *sql.Conn, fn func(c *conn) error) error {
+	return sqlConn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*conn)
+		if !ok {
+			return fmt.Errorf("invalid driver connection type %T", driverConn)
+		}
+		return fn(c)
+	})
+}
+
+// namedValues converts args to driver named values (sql.NamedArg arguments are converted to named values).
+func namedValues(args []any) []driver.NamedValue {
+	nvargs := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		nvargs[i].Ordinal = i + 1
+		if namedArg, ok := arg.(sql.NamedArg); ok {
+			nvargs[i].Name, arg = namedArg.Name, namedArg.Value
+		}
+		nvargs[i].Value = arg
+	}
+	return nvargs
+}
+
+// queryRows executes the query and returns the rows and a function closing the prepared statement if needed.
+func (c *conn) queryRows(ctx context.Context, query string, args []any) (driver.Rows, func(), error) {
+	if len(args) == 0 {
+		rows, err := c.QueryContext(ctx, query, nil)
+		return rows, func() {}, err
+	}
+	driverStmt, err := c.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := driverStmt.(*stmt).QueryContext(ctx, namedValues(args))
+	if err != nil {
+		driverStmt.Close()
+		return nil, nil, err
+	}
+	return rows, func() { driverStmt.Close() }, nil
+}
+
+/*
+SetAppContext sets the session context variable key to value (SET '<key>' = '<value>').
+The value can be retrieved by GetAppContext or in sql statements by the SESSION_CONTEXT function.
+Session context variables set by SetAppContext are cleared when the connection is reset
+(e.g. returned to the connection pool). Session variables sent on connect (see SessionVariables,
+ApplicationName and ApplicationUser) are reset to their connect value instead.
+*/
+func SetAppContext(ctx context.Context, sqlConn *sql.Conn, key, value string) error {
+	return rawConn(sqlConn, func(c *conn) error {
+		if _, err := c.ExecContext(ctx, fmt.Sprintf("set %s = %s", quoteLiteral(key), quoteLiteral(value)), nil); err != nil {
+			return err
+		}
+		if c.appContextKeys == nil {
+			c.appContextKeys = map[string]struct{}{}
+		}
+		c.appContextKeys[key] = struct{}{}
+		return nil
+	})
+}
+
+/*
+SetApplicationUser sets the application user (session context variable APPLICATIONUSER) of connection sqlConn,
+overriding the application user of the connector (see SetApplicationUser of Connector). Like for SetAppContext
+the application user is reset to the connector value when the connection is reset, so that in multi-tenant
+servers the application user can be set per request:
+
+	conn, err := db.Conn(ctx)
+	...
+	defer conn.Close()
+	if err := driver.SetApplicationUser(ctx, conn, tenantUser); err != nil {
+		...
+	}
+*/
+func SetApplicationUser(ctx context.Context, sqlConn *sql.Conn, user string) error {
+	return SetAppContext(ctx, sqlConn, svApplicationUser, user)
+}
+
+// GetAppContext returns the value of the session context variable key (SESSION_CONTEXT('<key>')).
+// In case the variable is not set sql.NullString.Valid is false.
+func GetAppContext(ctx context.Context, sqlConn *sql.Conn, key string) (sql.NullString, error) {
+	var value sql.NullString
+	err := sqlConn.QueryRowContext(ctx, "select session_context(?) from dummy", key).Scan(&value)
+	return value, err
+}
+
+// resetAppContext unsets the session context variables set by SetAppContext or
+// resets them to their value sent as client info on connect.
+func (c *conn) resetAppContext(ctx context.Context) error {
+	for key := range c.appContextKeys {
+		query := "unset " + quoteLiteral(key)
+		if value, ok := c.clientInfo[key]; ok {
+			query = fmt.Sprintf("set %s = %s", quoteLiteral(key), quoteLiteral(value))
+		}
+		if _, err := c.execDirect(ctx, query, !c.inTx); err != nil {
+			return err
+		}
+		delete(c.appContextKeys, key)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAppContext(t *testing.T) {
+	t.Parallel()
+
+	const key, value = "APP_KEY", "it's a value"
+
+	ctx := context.Background()
+
+	sqlConn, err := MT.DB().Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	if err := SetAppContext(ctx, sqlConn, key, value); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := GetAppContext(ctx, sqlConn, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Valid || v.String != value {
+		t.Fatalf("value %v - expected %s", v, value)
+	}
+
+	// reset session
+	if err := sqlConn.Raw(func(driverConn any) error {
+		return driverConn.(*conn).ResetSession(ctx)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err = GetAppContext(ctx, sqlConn, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Valid {
+		t.Fatalf("value %v - expected NULL after session reset", v)
+	}
+}
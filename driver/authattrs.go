@@ -1,6 +1,10 @@
 package driver
 
 import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -17,11 +21,13 @@ type authAttrs struct {
 	_username, _password string        // basic authentication
 	_certKey             *auth.CertKey // X509
 	_token               string        // JWT
-	_logonname           string        // session cookie login does need logon name provided by JWT authentication.
+	_logonname           string        // session cookie login does need logon name provided by JWT or SAML authentication.
 	_sessionCookie       []byte        // authentication via session cookie (HDB currently does support only SAML and JWT - go-hdb JWT)
 	_refreshPassword     func() (password string, ok bool)
 	_refreshClientCert   func() (clientCert, clientKey []byte, ok bool)
 	_refreshToken        func() (token string, ok bool)
+	_samlProvider        func(ctx context.Context) (assertion string, err error)
+	_preferredMethods    []string   // preferred authentication method types
 	cbmu                 sync.Mutex // prevents refresh callbacks from being called in parallel
 }
 
@@ -44,6 +50,8 @@ func (c *authAttrs) clone() *authAttrs {
 		_refreshPassword:   c._refreshPassword,
 		_refreshClientCert: c._refreshClientCert,
 		_refreshToken:      c._refreshToken,
+		_samlProvider:      c._samlProvider,
+		_preferredMethods:  c._preferredMethods,
 	}
 }
 
@@ -60,7 +68,12 @@ func (c *authAttrs) cookieAuth() *p.AuthHnd {
 	return auth
 }
 
-func (c *authAttrs) authHnd() *p.AuthHnd {
+func (c *authAttrs) authHnd(ctx context.Context) (*p.AuthHnd, error) {
+	assertion, err := c.samlAssertion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
@@ -71,6 +84,9 @@ func (c *authAttrs) authHnd() *p.AuthHnd {
 	if c._token != "" {
 		authHnd.AddJWT(c._token)
 	}
+	if assertion != "" {
+		authHnd.AddSAML(assertion)
+	}
 	// mimic standard drivers and use password as token if user is empty
 	if c._token == "" && c._username == "" && isJWTToken(c._password) {
 		authHnd.AddJWT(c._password)
@@ -78,7 +94,26 @@ func (c *authAttrs) authHnd() *p.AuthHnd {
 	if c._password != "" {
 		authHnd.AddBasic(c._username, c._password)
 	}
-	return authHnd
+	if c._preferredMethods != nil {
+		authHnd.SetPreferredMethods(c._preferredMethods)
+	}
+	return authHnd, nil
+}
+
+// samlAssertion requests a SAML assertion from the SAML assertion provider (if set).
+func (c *authAttrs) samlAssertion(ctx context.Context) (string, error) {
+	c.mu.RLock()
+	samlProvider := c._samlProvider
+	c.mu.RUnlock() // unlock attr, so that callback can call attr methods
+
+	if samlProvider == nil {
+		return "", nil
+	}
+	assertion, err := samlProvider(ctx)
+	if err != nil {
+		return "", fmt.Errorf("SAML assertion provider: %w", err)
+	}
+	return assertion, nil
 }
 
 func (c *authAttrs) callRefreshPasswordWithLock(refreshPassword func() (string, bool)) (string, bool) {
@@ -183,6 +218,17 @@ func (c *authAttrs) ClientCert() (clientCert, clientKey []byte) {
 	return c._certKey.Cert(), c._certKey.Key()
 }
 
+// tlsClientCertificate implements the tls.Config GetClientCertificate callback presenting the validated
+// X509 authentication client certificate (an empty certificate in case no client certificate is set).
+func (c *authAttrs) tlsClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c._certKey == nil {
+		return &tls.Certificate{}, nil
+	}
+	return c._certKey.TLSCertificate()
+}
+
 // RefreshClientCert returns the callback function for X509 authentication client certificate and key refresh.
 func (c *authAttrs) RefreshClientCert() func() (clientCert, clientKey []byte, ok bool) {
 	c.mu.RLock()
@@ -217,3 +263,50 @@ func (c *authAttrs) SetRefreshToken(refreshToken func() (token string, ok bool))
 	defer c.mu.Unlock()
 	c._refreshToken = refreshToken
 }
+
+// SAMLAssertionProvider returns the callback function providing the SAML authentication assertion.
+func (c *authAttrs) SAMLAssertionProvider() func(ctx context.Context) (assertion string, err error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._samlProvider
+}
+
+// SetSAMLAssertionProvider sets the callback function providing the SAML authentication assertion.
+// The callback function is called with the context of the connection attempt whenever a session is established
+// or re-established (e.g. after the session cookie of a pooled connection got invalid), so that an expired
+// assertion can be replaced by a fresh one. An error returned by the callback function fails the connection attempt.
+// The callback function might be called simultaneously from multiple goroutines.
+func (c *authAttrs) SetSAMLAssertionProvider(samlProvider func(ctx context.Context) (assertion string, err error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._samlProvider = samlProvider
+}
+
+// PreferredAuthMethods returns the preferred authentication method types of the connector.
+func (c *authAttrs) PreferredAuthMethods() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c._preferredMethods)
+}
+
+/*
+SetPreferredAuthMethods sets the authentication method types offered to the database server during the
+authentication handshake in the order of preference, e.g.
+
+	connector.SetPreferredAuthMethods([]string{"SCRAMSHA256"}) // do not use SCRAMPBKDF2SHA256
+
+By default (nil) all authentication methods available by the connector attributes are offered
+(X509, JWT, SAML, SCRAMPBKDF2SHA256 and SCRAMSHA256 in this order).
+If set, only the methods available and contained in methods are offered. Establishing a connection fails
+  - in case none of the preferred methods is available (e.g. SCRAMSHA256 without setting a password) or
+  - in case methods contains a method type not supported by the driver or
+  - in case the database server selects a method not contained in methods.
+
+Supported method types are SCRAMSHA256, SCRAMPBKDF2SHA256, X509, JWT and SAML.
+Reconnects via session cookie (JWT and SAML) are not affected.
+*/
+func (c *authAttrs) SetPreferredAuthMethods(methods []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._preferredMethods = slices.Clone(methods)
+}
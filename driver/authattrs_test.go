@@ -5,8 +5,11 @@ package driver
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"sync"
 	"testing"
+	"time"
 )
 
 // test if concurrent refresh would deadlock.
@@ -92,6 +95,89 @@ func testRefresh(t *testing.T) {
 	}
 }
 
+func testJWTDSN(t *testing.T) {
+	const invalidToken, refreshedToken = "eyInvalidToken", "eyRefreshedToken"
+
+	connector, err := NewDSNConnector(fmt.Sprintf("hdb://%s?%s=%s", MT.Connector().Host(), DSNToken, invalidToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if connector.Token() != invalidToken {
+		t.Fatalf("token %s - expected %s", connector.Token(), invalidToken)
+	}
+	connector.SetTLSConfig(MT.Connector().TLSConfig())
+
+	numRefresh := 0
+	connector.SetRefreshToken(func() (string, bool) {
+		numRefresh++
+		return refreshedToken, true
+	})
+
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	err = db.Ping()
+	if !IsAuthenticationFailed(err) {
+		t.Fatalf("error %v - expected authentication failed error", err)
+	}
+	var dbErr Error
+	if !errors.As(err, &dbErr) {
+		t.Fatalf("error %v - expected %T", err, dbErr)
+	}
+	if numRefresh != 1 {
+		t.Fatalf("number of token refreshs %d - expected %d", numRefresh, 1)
+	}
+	if connector.Token() != refreshedToken {
+		t.Fatalf("token %s - expected %s", connector.Token(), refreshedToken)
+	}
+}
+
+func testSAMLProvider(t *testing.T) {
+	errProvider := errors.New("assertion not available")
+
+	tests := []struct {
+		name      string
+		assertion string
+		err       error
+	}{
+		{"providerError", "", errProvider},
+		{"invalidAssertion", "invalid assertion", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			numCall := 0
+			connector := NewSAMLAuthConnector(MT.Connector().Host(), func(ctx context.Context) (string, error) {
+				numCall++
+				if _, ok := ctx.Deadline(); !ok {
+					t.Error("context deadline not propagated to SAML assertion provider")
+				}
+				return test.assertion, test.err
+			})
+			connector.SetTLSConfig(MT.Connector().TLSConfig())
+
+			db := sql.OpenDB(connector)
+			defer db.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			err := db.PingContext(ctx)
+			switch {
+			case test.err != nil:
+				if !errors.Is(err, test.err) {
+					t.Fatalf("error %v - expected %v", err, test.err)
+				}
+			case !IsAuthenticationFailed(err):
+				t.Fatalf("error %v - expected authentication failed error", err)
+			}
+			if numCall == 0 {
+				t.Fatal("SAML assertion provider not called")
+			}
+		})
+	}
+}
+
 func TestAuthAttrs(t *testing.T) {
 	t.Parallel()
 
@@ -101,6 +187,8 @@ func TestAuthAttrs(t *testing.T) {
 	}{
 		{"testRefreshDeadlock", testRefreshDeadlock},
 		{"testRefresh", testRefresh},
+		{"testJWTDSN", testJWTDSN},
+		{"testSAMLProvider", testSAMLProvider},
 	}
 
 	for _, test := range tests {
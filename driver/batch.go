@@ -0,0 +1,135 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+/*
+Batch accumulates rows for a prepared statement (e.g. insert) and executes them in one bulk exec (flush) as soon
+as the number of accumulated rows reaches maxRows or the estimated size of the accumulated values reaches maxBytes.
+
+	stmt, err := db.PrepareContext(ctx, "insert into t values (?, ?)")
+	...
+	batch := driver.NewBatch(stmt, 10000, 0)
+	for _, r := range records {
+		batch.Append(ctx, r.id, r.name) // errors are accumulated
+	}
+	batch.Flush(ctx)
+	rowsAffected, err := batch.Result()
+
+The rows are executed like a bulk exec with multiple rows as arguments, so that the rows are written in packages
+of bulkSize (see Connector.SetBulkSize) and lob values are written piecewise in chunks of lobChunkSize
+(see Connector.SetLobChunkSize and WithLobChunkSize). As the rows are kept until the next flush, lob readers
+provided as argument need to stay readable until then.
+
+The size of the accumulated values is estimated by the length of string and byte slice values and the size of
+the Go type for all other values. The content of lob values (io.Reader) is not accounted for, as it is written
+piecewise anyway.
+
+A Batch is not safe for concurrent use by multiple goroutines.
+*/
+type Batch struct {
+	stmt     *sql.Stmt
+	maxRows  int
+	maxBytes int
+
+	numArg int   // number of arguments per row
+	args   []any // accumulated rows
+	numRow int
+	size   int
+
+	rowsAffected int64
+	errs         []error
+}
+
+// NewBatch returns a new Batch instance for the prepared statement stmt flushing the accumulated rows when reaching
+// maxRows rows or maxBytes bytes (a value <= 0 disables the respective threshold).
+func NewBatch(stmt *sql.Stmt, maxRows, maxBytes int) *Batch {
+	return &Batch{stmt: stmt, maxRows: maxRows, maxBytes: maxBytes}
+}
+
+/*
+Append appends a row with the statement arguments args and flushes the accumulated rows in case a threshold is
+reached. All rows need to have the same number of arguments. An error of the flush is returned and accumulated.
+*/
+func (b *Batch) Append(ctx context.Context, args ...any) error {
+	if len(args) == 0 {
+		return b.addError(errors.New("batch: empty row"))
+	}
+	if b.numRow == 0 {
+		b.numArg = len(args)
+	} else if len(args) != b.numArg {
+		return b.addError(fmt.Errorf("batch: invalid number of arguments %d - %d expected", len(args), b.numArg))
+	}
+	b.args = append(b.args, args...)
+	b.numRow++
+	for _, arg := range args {
+		b.size += estimatedSize(arg)
+	}
+	if (b.maxRows > 0 && b.numRow >= b.maxRows) || (b.maxBytes > 0 && b.size >= b.maxBytes) {
+		return b.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush executes the accumulated rows. The rows are discarded after the execution whether or not the execution
+// was successful. An error of the execution is returned and accumulated.
+func (b *Batch) Flush(ctx context.Context) error {
+	if b.numRow == 0 {
+		return nil
+	}
+	result, err := b.stmt.ExecContext(ctx, b.args...)
+	if result != nil {
+		if rows, err := result.RowsAffected(); err == nil {
+			b.rowsAffected += rows
+		}
+	}
+	clear(b.args) // release references to values (e.g. lob readers)
+	b.args = b.args[:0]
+	b.numRow, b.size = 0, 0
+	if err != nil {
+		return b.addError(err)
+	}
+	return nil
+}
+
+// Len returns the number of accumulated rows not flushed yet.
+func (b *Batch) Len() int { return b.numRow }
+
+// Result returns the total number of rows affected by all flushes and the accumulated errors (nil if none).
+// Rows not flushed yet are not executed by Result.
+func (b *Batch) Result() (int64, error) { return b.rowsAffected, errors.Join(b.errs...) }
+
+func (b *Batch) addError(err error) error {
+	b.errs = append(b.errs, err)
+	return err
+}
+
+// estimatedSize returns the estimated number of bytes of argument value v.
+func estimatedSize(v any) int {
+	switch v := v.(type) {
+	case nil:
+		return 1
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	case bool, int8, uint8:
+		return 1
+	case int16, uint16:
+		return 2
+	case int32, uint32, float32:
+		return 4
+	case time.Time, Decimal, *Decimal:
+		return 16
+	case io.Reader, Lob, *Lob:
+		return 0 // lob content is written piecewise
+	default:
+		return 8
+	}
+}
@@ -0,0 +1,172 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func testBatchRows(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	table := RandomIdentifier("batchRows_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, s nvarchar(20))", table)); err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := db.PrepareContext(ctx, fmt.Sprintf("insert into %s values (?, ?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	const numRow = 25
+	batch := NewBatch(stmt, 10, 0)
+	for i := 0; i < numRow; i++ {
+		if err := batch.Append(ctx, i, fmt.Sprintf("row%d", i)); err != nil {
+			t.Fatal(err)
+		}
+		if expected := (i + 1) % 10; batch.Len() != expected {
+			t.Fatalf("row %d: number of accumulated rows %d - expected %d", i, batch.Len(), expected)
+		}
+	}
+	if err := batch.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	rowsAffected, err := batch.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rowsAffected != numRow {
+		t.Fatalf("rows affected %d - expected %d", rowsAffected, numRow)
+	}
+
+	var n int
+	if err := db.QueryRowContext(ctx, fmt.Sprintf("select count(*) from %s", table)).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != numRow {
+		t.Fatalf("number of rows %d - expected %d", n, numRow)
+	}
+}
+
+func testBatchLob(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	table := RandomIdentifier("batchLob_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, c nclob)", table)); err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := db.PrepareContext(ctx, fmt.Sprintf("insert into %s values (?, ?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	const numRow = 7
+	content := func(i int) string { return strings.Repeat(fmt.Sprintf("%d", i), 1000*(i+1)) }
+
+	batch := NewBatch(stmt, 3, 0)
+	for i := 0; i < numRow; i++ {
+		var lob any
+		if i%2 == 0 { // mixed lob and null values
+			lob = NewLob(strings.NewReader(content(i)), nil)
+		}
+		if err := batch.Append(ctx, i, lob); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := batch.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if rowsAffected, err := batch.Result(); err != nil || rowsAffected != numRow {
+		t.Fatalf("rows affected %d error %v - expected %d", rowsAffected, err, numRow)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("select i, c from %s order by i", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i int
+		var s sql.NullString
+		if err := rows.Scan(&i, &s); err != nil {
+			t.Fatal(err)
+		}
+		if s.Valid != (i%2 == 0) || (s.Valid && s.String != content(i)) {
+			t.Fatalf("row %d: unexpected lob value (valid %t length %d)", i, s.Valid, len(s.String))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testBatchErrors(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	table := RandomIdentifier("batchErrors_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer primary key)", table)); err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := db.PrepareContext(ctx, fmt.Sprintf("insert into %s values (?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	batch := NewBatch(stmt, 0, 16) // flush by size: two integer values
+	if err := batch.Append(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Append(ctx, 1, 2); err == nil { // invalid number of arguments
+		t.Fatal("invalid number of arguments error expected")
+	}
+	if err := batch.Append(ctx, 2); err != nil { // flush
+		t.Fatal(err)
+	}
+	if batch.Len() != 0 {
+		t.Fatalf("number of accumulated rows %d - expected 0", batch.Len())
+	}
+	if err := batch.Append(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Append(ctx, 1); err == nil { // flush: unique constraint violation
+		t.Fatal("unique constraint violation error expected")
+	}
+
+	rowsAffected, err := batch.Result()
+	if err == nil {
+		t.Fatal("accumulated errors expected")
+	}
+	if rowsAffected < 2 {
+		t.Fatalf("rows affected %d - expected at least %d", rowsAffected, 2)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fct  func(t *testing.T, db *sql.DB)
+	}{
+		{"rows", testBatchRows},
+		{"lob", testBatchLob},
+		{"errors", testBatchErrors},
+	}
+
+	db := MT.DB()
+	for _, test := range tests {
+		test := test // new test to run in parallel
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			test.fct(t, db)
+		})
+	}
+}
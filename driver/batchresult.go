@@ -0,0 +1,104 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// Row counts of a BatchResult not reporting a number of affected rows.
+const (
+	// RowCountUnknown is reported for rows executed successfully where the database server
+	// does not provide the number of affected rows.
+	RowCountUnknown = p.RaUnknown
+	// RowCountFailed is reported for rows where the execution failed.
+	RowCountFailed = p.RaExecutionFailed
+)
+
+/*
+BatchResult is the result of a (bulk) statement execution providing the number of affected rows
+per batch element (row) as returned by the database server.
+
+The database server reports the number of affected rows only and does not provide a breakdown of
+inserted, updated and deleted rows for upsert (replace) or merge statements, so the row count of an
+upsert batch element is 1 regardless of the row being inserted or updated, and the row count of a merge
+statement is the sum of the rows affected by all of its clauses. In case the breakdown is needed
+(e.g. for change data capture) the affected rows need to be determined by the application, e.g. by
+querying the existing keys upfront or by separate insert, update and delete statements.
+
+The sql.Result returned by sql.DB.Exec cannot be type-asserted to a BatchResult - use ExecBatch instead.
+*/
+type BatchResult struct {
+	rowCounts []int64
+}
+
+var _ driver.Result = (*BatchResult)(nil)
+
+// LastInsertId implements the driver.Result interface.
+func (r *BatchResult) LastInsertId() (int64, error) { return driver.RowsAffected(0).LastInsertId() }
+
+// RowsAffected implements the driver.Result interface and returns the total number of affected rows.
+func (r *BatchResult) RowsAffected() (int64, error) { return r.total(), nil }
+
+/*
+RowCounts returns the number of affected rows for each batch element in order of the statement arguments.
+A row count is
+  - >= 0 for the number of rows affected by the batch element
+  - RowCountUnknown (-1) in case the batch element got executed successfully, but the database server did not report the number of affected rows
+  - RowCountFailed in case the execution of the batch element failed
+
+In case the execution got aborted (e.g. by an error or a cancelled context), the row counts of the
+batch elements sent to the database server so far are returned only.
+*/
+func (r *BatchResult) RowCounts() []int64 {
+	counts := make([]int64, len(r.rowCounts))
+	copy(counts, r.rowCounts)
+	return counts
+}
+
+func (r *BatchResult) total() int64 {
+	total := int64(0)
+	for _, rows := range r.rowCounts {
+		if rows > 0 {
+			total += rows
+		}
+	}
+	return total
+}
+
+func (r *BatchResult) add(result driver.Result) {
+	if result == nil {
+		return
+	}
+	if batchResult, ok := result.(*BatchResult); ok {
+		r.rowCounts = append(r.rowCounts, batchResult.rowCounts...)
+		return
+	}
+	if rows, err := result.RowsAffected(); err == nil {
+		r.rowCounts = append(r.rowCounts, rows)
+	}
+}
+
+/*
+ExecBatch executes the statement query with args on connection sqlConn like sql.Conn.ExecContext
+and returns the result as BatchResult providing the number of affected rows per batch element.
+All arguments supported by sql.Conn.ExecContext (e.g. multiple rows or a bulk function) are supported.
+In case of an error the (partial) result is returned together with the error.
+*/
+func ExecBatch(ctx context.Context, sqlConn *sql.Conn, query string, args ...any) (*BatchResult, error) {
+	result := &BatchResult{}
+	err := rawConn(sqlConn, func(c *conn) error {
+		driverStmt, err := c.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer driverStmt.Close()
+
+		r, err := driverStmt.(*stmt).ExecContext(ctx, namedValues(args))
+		result.add(r)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,77 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+func testBoolean(t *testing.T, tableName Identifier, dfv int) {
+	connector := MT.NewConnector()
+	connector.SetDfv(dfv) // dfv < 7: BOOLEAN columns are represented as TINYINT
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	// numeric values other than zero are bound as true
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?), (?, ?), (?, ?), (?, ?)", tableName), dfv, true, dfv, int64(0), dfv, int64(2), dfv, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select b from %s where dfv = ? order by b", tableName), dfv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var values []sql.NullBool
+	for rows.Next() {
+		var b sql.NullBool
+		if err := rows.Scan(&b); err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, b)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []sql.NullBool{{}, {Valid: true, Bool: false}, {Valid: true, Bool: true}, {Valid: true, Bool: true}} // nulls first
+	if len(values) != len(expected) {
+		t.Fatalf("number of values %d - expected %d", len(values), len(expected))
+	}
+	for i, v := range values {
+		if v != expected[i] {
+			t.Fatalf("value %d: %v - expected %v", i, v, expected[i])
+		}
+	}
+
+	var cnt int
+	if err := db.QueryRow(fmt.Sprintf("select count(*) from %s where dfv = ? and b = ?", tableName), dfv, true).Scan(&cnt); err != nil {
+		t.Fatal(err)
+	}
+	if cnt != 2 {
+		t.Fatalf("number of true values %d - expected 2", cnt)
+	}
+}
+
+func TestBoolean(t *testing.T) {
+	t.Parallel()
+
+	tableName := RandomIdentifier("boolean_")
+
+	if _, err := MT.DB().Exec(fmt.Sprintf("create table %s (dfv integer, b boolean)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dfv := range []int{p.DfvLevel6, p.DfvLevel8} {
+		dfv := dfv // new dfv to run in parallel
+
+		t.Run(fmt.Sprintf("dfv %d", dfv), func(t *testing.T) {
+			t.Parallel()
+			testBoolean(t, tableName, dfv)
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math/big"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -384,6 +386,477 @@ func testBulkGeo(t *testing.T, ctr *Connector, db *sql.DB) {
 	}
 }
 
+func testBulkParameterLimit(t *testing.T, ctr *Connector, db *sql.DB) {
+	const numField = 3
+	numRow := MaxNumParameter/numField + ctr.BulkSize() // number of total parameter values exceeds MaxNumParameter
+
+	tableName := RandomIdentifier("bulkParameterLimit_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, j integer, k integer)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	args := make([]any, 0, numRow*numField)
+	for i := 0; i < numRow; i++ {
+		args = append(args, i, i, i)
+	}
+	// bulk insert gets chunked by bulk size
+	result, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?, ?)", tableName), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rowsAffected != int64(numRow) {
+		t.Fatalf("rows affected %d - expected %d", rowsAffected, numRow)
+	}
+
+	// statement parameters exceeding the limit
+	inList := "?" + strings.Repeat(",?", MaxNumParameter)
+	inArgs := make([]any, MaxNumParameter+1)
+	for i := range inArgs {
+		inArgs[i] = i
+	}
+	if _, err := db.Query(fmt.Sprintf("select * from %s where i in (%s)", tableName, inList), inArgs...); err == nil {
+		t.Fatal("expected parameter limit error")
+	}
+}
+
+func testBulkDecimalValue(i int) *big.Rat {
+	return big.NewRat(int64(i)*1000+int64(i%1000), 1000) // scale 3
+}
+
+func testBulkDecimalInsert(tb testing.TB, db *sql.DB, numRow int) Identifier {
+	tableName := RandomIdentifier("bulkDecimal_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, d decimal(18,3))", tableName)); err != nil {
+		tb.Fatal(err)
+	}
+	i := 0
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", tableName), func(args []any) error {
+		if i >= numRow {
+			return ErrEndOfRows
+		}
+		args[0], args[1] = i, (*Decimal)(testBulkDecimalValue(i))
+		i++
+		return nil
+	}); err != nil {
+		tb.Fatal(err)
+	}
+	return tableName
+}
+
+func testBulkDecimal(t *testing.T, ctr *Connector, db *sql.DB) {
+	const numRow = 10000
+
+	tableName := testBulkDecimalInsert(t, db, numRow)
+
+	rows, err := db.Query(fmt.Sprintf("select i, d from %s order by i", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	cnt := 0
+	for rows.Next() {
+		var i int
+		var d Decimal
+		if err := rows.Scan(&i, &d); err != nil {
+			t.Fatal(err)
+		}
+		if (*big.Rat)(&d).Cmp(testBulkDecimalValue(i)) != 0 {
+			t.Fatalf("row %d: decimal %s - expected %s", i, (*big.Rat)(&d).FloatString(3), testBulkDecimalValue(i).FloatString(3))
+		}
+		cnt++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if cnt != numRow {
+		t.Fatalf("number of rows %d - expected %d", cnt, numRow)
+	}
+}
+
+func BenchmarkBulkDecimal(b *testing.B) {
+	const numRow = 100000
+
+	db := MT.DB()
+	for i := 0; i < b.N; i++ {
+		testBulkDecimalInsert(b, db, numRow)
+	}
+}
+
+type testBulkID int32
+
+func testBulkConverterInsert(tb testing.TB, db *sql.DB, convert bool, numRow int) Identifier {
+	ctx := context.Background()
+
+	tableName := RandomIdentifier("bulkConverter_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, s nvarchar(20), d decimal(18,3))", tableName)); err != nil {
+		tb.Fatal(err)
+	}
+	query := fmt.Sprintf("insert into %s values (?, ?, ?)", tableName)
+
+	var conv *ParameterConverter
+	if convert {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		defer conn.Close()
+		m, err := DescribeStmt(ctx, conn, query)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if conv, err = NewParameterConverter(m); err != nil {
+			tb.Fatal(err)
+		}
+	}
+
+	i := 0
+	if _, err := db.ExecContext(ctx, query, func(args []any) error {
+		if i >= numRow {
+			return ErrEndOfRows
+		}
+		args[0], args[1], args[2] = testBulkID(i), sql.NullString{String: strconv.Itoa(i), Valid: true}, (*Decimal)(testBulkDecimalValue(i))
+		i++
+		if conv != nil {
+			return conv.ConvertRow(args)
+		}
+		return nil
+	}); err != nil {
+		tb.Fatal(err)
+	}
+	return tableName
+}
+
+func testBulkConverter(t *testing.T, ctr *Connector, db *sql.DB) {
+	const numRow = 1000
+
+	tableName := testBulkConverterInsert(t, db, true, numRow)
+
+	rows, err := db.Query(fmt.Sprintf("select i, s, d from %s order by i", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	cnt := 0
+	for rows.Next() {
+		var i int
+		var s string
+		var d Decimal
+		if err := rows.Scan(&i, &s, &d); err != nil {
+			t.Fatal(err)
+		}
+		if i != cnt || s != strconv.Itoa(i) || (*big.Rat)(&d).Cmp(testBulkDecimalValue(i)) != 0 {
+			t.Fatalf("row %d: invalid values %d %s %s", cnt, i, s, (*big.Rat)(&d).FloatString(3))
+		}
+		cnt++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if cnt != numRow {
+		t.Fatalf("number of rows %d - expected %d", cnt, numRow)
+	}
+}
+
+func BenchmarkBulkConverter(b *testing.B) {
+	const numRow = 100000
+
+	db := MT.DB()
+
+	b.Run("reflective", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			testBulkConverterInsert(b, db, false, numRow)
+		}
+	})
+	b.Run("precompiled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			testBulkConverterInsert(b, db, true, numRow)
+		}
+	})
+}
+
+func testBulkCancel(t *testing.T, ctr *Connector, db *sql.DB) {
+	t.Run("clientCancel", func(t *testing.T) { bulkCancel(t, ctr.BulkSize(), db) })
+	t.Run("serverCancel", func(t *testing.T) {
+		cancelCtr := ctr.clone()
+		cancelCtr.SetServerCancel(true) // in-progress package is cancelled on the database server as well
+		cancelDB := sql.OpenDB(cancelCtr)
+		defer cancelDB.Close()
+		bulkCancel(t, cancelCtr.BulkSize(), cancelDB)
+	})
+}
+
+func bulkCancel(t *testing.T, bulkSize int, db *sql.DB) {
+	numBatch := 2 // number of complete packages before the cancellation
+
+	tableName := RandomIdentifier("bulkCancel_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	i := 0
+	_, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?)", tableName), func(args []any) error {
+		if i == numBatch*bulkSize+bulkSize/2 { // cancel in the middle of buffering the next package
+			cancel()
+		}
+		args[0] = i
+		i++
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error %v - expected %v", err, context.Canceled)
+	}
+	var cancelErr *BulkCancelError
+	if !errors.As(err, &cancelErr) {
+		t.Fatalf("error %v - expected %T", err, cancelErr)
+	}
+	if cancelErr.RowsAffected != int64(numBatch*bulkSize) {
+		t.Fatalf("rows affected %d - expected %d", cancelErr.RowsAffected, numBatch*bulkSize)
+	}
+	if i != numBatch*bulkSize+bulkSize/2+1 { // buffering stopped
+		t.Fatalf("number of buffered rows %d - expected %d", i, numBatch*bulkSize+bulkSize/2+1)
+	}
+
+	// auto-commit: rows of completed packages are committed
+	var cnt int64
+	if err := db.QueryRow(fmt.Sprintf("select count(*) from %s", tableName)).Scan(&cnt); err != nil {
+		t.Fatal(err)
+	}
+	if cnt != cancelErr.RowsAffected {
+		t.Fatalf("number of rows %d - expected %d", cnt, cancelErr.RowsAffected)
+	}
+}
+
+func testBulkInserterColumns(numRow int) (ids []int64, texts []string, blobs []any) {
+	ids, texts, blobs = make([]int64, numRow), make([]string, numRow), make([]any, numRow)
+	for i := 0; i < numRow; i++ {
+		ids[i], texts[i], blobs[i] = int64(i), strconv.Itoa(i), strings.Repeat("b", i%3)
+	}
+	return ids, texts, blobs
+}
+
+func testBulkInserterRowsInsert(tb testing.TB, db *sql.DB, numRow int) {
+	tableName := RandomIdentifier("bulkInserterRows_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i bigint, s nvarchar(20))", tableName)); err != nil {
+		tb.Fatal(err)
+	}
+	ids, texts, _ := testBulkInserterColumns(numRow)
+	i := 0
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", tableName), func(args []any) error {
+		if i >= numRow {
+			return ErrEndOfRows
+		}
+		args[0], args[1] = ids[i], texts[i]
+		i++
+		return nil
+	}); err != nil {
+		tb.Fatal(err)
+	}
+}
+
+func testBulkInserterColumnsInsert(tb testing.TB, db *sql.DB, numRow int) {
+	tableName := RandomIdentifier("bulkInserterColumns_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i bigint, s nvarchar(20))", tableName)); err != nil {
+		tb.Fatal(err)
+	}
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values (?, ?)", tableName))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer stmt.Close()
+	ids, texts, _ := testBulkInserterColumns(numRow)
+	if _, err := NewBulkInserter(stmt).Exec(context.Background(), ids, texts); err != nil {
+		tb.Fatal(err)
+	}
+}
+
+func testBulkInserterConverted(t *testing.T, db *sql.DB, bulkInserter *BulkInserter, query string, columns ...any) {
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	m, err := DescribeStmt(ctx, conn, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conv, err := NewParameterConverter(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	converted := make([]any, len(columns))
+	for i, column := range columns {
+		if converted[i], err = conv.ConvertColumn(i, column); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := bulkInserter.Exec(ctx, converted...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testBulkInserter(t *testing.T, ctr *Connector, db *sql.DB) {
+	numRow := ctr.BulkSize()*2 + 10 // more than one package
+	bigBlob := strings.Repeat("x", ctr.LobChunkSize()+1)
+
+	tableName := RandomIdentifier("bulkInserter_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i bigint, s nvarchar(20), b blob)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values (?, ?, ?)", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	ids, texts, blobs := testBulkInserterColumns(numRow)
+	blobs[numRow/2] = bigBlob // piecewise lob writing
+
+	bulkInserter := NewBulkInserter(stmt)
+	result, err := bulkInserter.Exec(context.Background(), ids, texts, blobs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rowsAffected != int64(numRow) {
+		t.Fatalf("rows affected %d - expected %d", rowsAffected, numRow)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select i, s, b from %s order by i", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	cnt := 0
+	for rows.Next() {
+		var i int64
+		var s string
+		var b stringLob // defined in lob_test
+		if err := rows.Scan(&i, &s, &b); err != nil {
+			t.Fatal(err)
+		}
+		if i != ids[cnt] || s != texts[cnt] || string(b) != blobs[cnt] {
+			t.Fatalf("row %d: invalid values %d %s len %d", cnt, i, s, len(b))
+		}
+		cnt++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if cnt != numRow {
+		t.Fatalf("number of rows %d - expected %d", cnt, numRow)
+	}
+
+	// columns converted by a parameter converter
+	testBulkInserterConverted(t, db, bulkInserter, fmt.Sprintf("insert into %s values (?, ?, ?)", tableName), ids, texts, blobs)
+	var numConverted int
+	if err := db.QueryRow(fmt.Sprintf("select count(*) from %s", tableName)).Scan(&numConverted); err != nil {
+		t.Fatal(err)
+	}
+	if numConverted != 2*numRow {
+		t.Fatalf("number of rows %d - expected %d", numConverted, 2*numRow)
+	}
+
+	// invalid columns
+	if _, err := bulkInserter.Exec(context.Background(), ids, texts); err == nil {
+		t.Fatal("invalid number of columns error expected")
+	}
+	if _, err := bulkInserter.Exec(context.Background(), ids, texts[1:], blobs); err == nil {
+		t.Fatal("invalid number of values error expected")
+	}
+	if _, err := bulkInserter.Exec(context.Background(), ids, texts, 42); err == nil {
+		t.Fatal("invalid column type error expected")
+	}
+}
+
+func BenchmarkBulkInserter(b *testing.B) {
+	const numRow = 100000
+
+	db := MT.DB()
+
+	b.Run("rows", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			testBulkInserterRowsInsert(b, db, numRow)
+		}
+	})
+	b.Run("columns", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			testBulkInserterColumnsInsert(b, db, numRow)
+		}
+	})
+}
+
+func testBulkRowCounts(t *testing.T, ctr *Connector, db *sql.DB) {
+	ctx := context.Background()
+
+	tableName := RandomIdentifier("bulkRowCounts_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer primary key, s nvarchar(20))", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// more rows than bulk size (several packages)
+	numRow := ctr.BulkSize() + 5
+	args := make([]any, 0, numRow*2)
+	for i := 0; i < numRow; i++ {
+		args = append(args, i, strconv.Itoa(i))
+	}
+	result, err := ExecBatch(ctx, conn, fmt.Sprintf("insert into %s values (?, ?)", tableName), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowCounts := result.RowCounts()
+	if len(rowCounts) != numRow {
+		t.Fatalf("number of row counts %d - expected %d", len(rowCounts), numRow)
+	}
+	for i, rowCount := range rowCounts {
+		if rowCount != 1 && rowCount != RowCountUnknown {
+			t.Fatalf("row %d: row count %d - expected 1", i, rowCount)
+		}
+	}
+
+	// delete existing and non existing rows
+	result, err = ExecBatch(ctx, conn, fmt.Sprintf("delete from %s where i = ?", tableName), 0, numRow, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int64{1, 0, 1}
+	rowCounts = result.RowCounts()
+	if len(rowCounts) != len(expected) {
+		t.Fatalf("row counts %v - expected %v", rowCounts, expected)
+	}
+	for i, rowCount := range rowCounts {
+		if rowCount != expected[i] && rowCount != RowCountUnknown {
+			t.Fatalf("row counts %v - expected %v", rowCounts, expected)
+		}
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rowsAffected > 2 {
+		t.Fatalf("rows affected %d - expected <= %d", rowsAffected, 2)
+	}
+}
+
 func TestBulk(t *testing.T) {
 	t.Parallel()
 
@@ -396,6 +869,12 @@ func TestBulk(t *testing.T) {
 		{"testBulkBlob", testBulkBlob},
 		{"testBulkBlob106", testBulkBlob106},
 		{"testBulkGeo", testBulkGeo},
+		{"testBulkParameterLimit", testBulkParameterLimit},
+		{"testBulkDecimal", testBulkDecimal},
+		{"testBulkConverter", testBulkConverter},
+		{"testBulkCancel", testBulkCancel},
+		{"testBulkInserter", testBulkInserter},
+		{"testBulkRowCounts", testBulkRowCounts},
 	}
 
 	ctr := MT.NewConnector()
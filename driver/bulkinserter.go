@@ -0,0 +1,41 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+)
+
+// columnBatch is the statement argument type of the rows provided column-wise by a BulkInserter.
+type columnBatch []any
+
+/*
+BulkInserter is a bulk insert fast path for prepared insert statements.
+
+In contrast to a row based bulk insert, where every single value is converted on its own, the rows are
+provided column-wise (one slice of values per statement parameter) and the conversion is resolved once
+per column for the most common column types ([]int, []int32, []int64, []float64, []bool, []string and []time.Time).
+All other column types (e.g. []any, []Decimal or []Lob) are supported as well, but are converted value by value.
+Columns converted ahead of execution by a ParameterConverter (see ParameterConverter.ConvertColumn) are not
+converted again.
+Like for any other bulk insert the rows are written in packages of bulkSize (see Connector.SetBulkSize) and
+lob values are written piecewise in chunks of lobChunkSize (see Connector.SetLobChunkSize and WithLobChunkSize).
+
+	stmt, err := db.PrepareContext(ctx, "insert into t values (?, ?)")
+	...
+	result, err := driver.NewBulkInserter(stmt).Exec(ctx, []int64{1, 2, 3}, []string{"a", "b", "c"})
+*/
+type BulkInserter struct {
+	stmt *sql.Stmt
+}
+
+// NewBulkInserter returns a new BulkInserter instance for the prepared insert statement stmt.
+func NewBulkInserter(stmt *sql.Stmt) *BulkInserter { return &BulkInserter{stmt: stmt} }
+
+/*
+Exec executes the insert statement for all rows provided by columns. Each column needs to be a slice
+containing the values of the related statement parameter and all columns need to be of equal length.
+In case of a context cancellation a BulkCancelError is returned.
+*/
+func (b *BulkInserter) Exec(ctx context.Context, columns ...any) (sql.Result, error) {
+	return b.stmt.ExecContext(ctx, columnBatch(columns))
+}
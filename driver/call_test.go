@@ -6,8 +6,11 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/SAP/go-hdb/driver"
@@ -208,6 +211,143 @@ end
 	check(data[2], &resultRows3)
 }
 
+func testCallUnboundResults(t *testing.T, db *sql.DB) {
+	const procResults = `create procedure %[1]s (in i integer)
+language SQLSCRIPT as
+begin
+  select :i as i from dummy;
+  select :i + 1 as i from dummy;
+  select :i + 2 as i from dummy;
+end
+`
+	proc := driver.RandomIdentifier("procResults_")
+	if _, err := db.Exec(fmt.Sprintf(procResults, proc)); err != nil {
+		t.Fatal(err)
+	}
+
+	// use same connection
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	query := fmt.Sprintf("call %s(?)", proc)
+
+	// no result set is consumed
+	if _, err := conn.ExecContext(ctx, query, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the first result set is consumed
+	stmt, err := conn.PrepareContext(ctx, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows sql.Rows
+	if _, err := stmt.Exec(1, sql.Out{Dest: &rows}); err != nil {
+		t.Fatal(err)
+	}
+	var i int
+	for rows.Next() {
+		if err := rows.Scan(&i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != 1 {
+		t.Fatalf("value %d - expected %d", i, 1)
+	}
+	if err := stmt.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// connection is still usable
+	if err := conn.QueryRowContext(ctx, "select 42 from dummy").Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+	if i != 42 {
+		t.Fatalf("value %d - expected %d", i, 42)
+	}
+}
+
+func testCallQueryResults(t *testing.T, db *sql.DB) {
+	const procResults = `create procedure %[1]s (in i integer, out o integer, out s nvarchar(20))
+language SQLSCRIPT as
+begin
+  select :i as i from dummy;
+  select :i + 1 as i from dummy union all select :i + 2 as i from dummy;
+  o := :i + 10;
+  s := 'done';
+end
+`
+	proc := driver.RandomIdentifier("procQueryResults_")
+	if _, err := db.Exec(fmt.Sprintf(procResults, proc)); err != nil {
+		t.Fatal(err)
+	}
+
+	// use same connection
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var o int
+	var s string
+
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("call %s(?, ?, ?)", proc), 1, sql.Named("O", sql.Out{Dest: &o}), sql.Named("S", sql.Out{Dest: &s}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	expected := [][]int{{1}, {2, 3}}
+	var results [][]int
+	for {
+		var values []int
+		for rows.Next() {
+			var i int
+			if err := rows.Scan(&i); err != nil {
+				t.Fatal(err)
+			}
+			values = append(values, i)
+		}
+		results = append(results, values)
+		if len(results) < len(expected) && o != 0 {
+			t.Fatal("scalar output parameter assigned before all result sets are consumed")
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("results %v - expected %v", results, expected)
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if o != 11 || s != "done" {
+		t.Fatalf("output parameters %d %s - expected %d %s", o, s, 11, "done")
+	}
+
+	// connection is still usable
+	var i int
+	if err := conn.QueryRowContext(ctx, "select 42 from dummy").Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+	if i != 42 {
+		t.Fatalf("value %d - expected %d", i, 42)
+	}
+}
+
 func testCallNoPrm(t *testing.T, db *sql.DB) {
 	const procNoPrm = `create procedure %[1]s
 language SQLSCRIPT as
@@ -274,6 +414,117 @@ end
 	checkTable(conn, ctx, table)
 }
 
+func testCallArray(t *testing.T, db *sql.DB) {
+	const procIncrement = `create procedure %[1]s (inout i integer, in step integer, out s nvarchar(20))
+language SQLSCRIPT as
+begin
+	i := :i + :step;
+	s := 'value ' || to_nvarchar(:i);
+end
+`
+	const procInsert = `create procedure %[1]s (in i integer, in step integer)
+language SQLSCRIPT as
+begin
+	insert into %[2]s values(:i + :step);
+end
+`
+
+	proc := driver.RandomIdentifier("procIncrement_")
+	if _, err := db.Exec(fmt.Sprintf(procIncrement, proc)); err != nil {
+		t.Fatal(err)
+	}
+
+	// not supported: procedures with output parameters
+	values := []int{1, 2, 3, 42}
+	var s string
+	if _, err := db.Exec(fmt.Sprintf("call %s(?, ?, ?)", proc), sql.Out{Dest: &values, In: true}, 10, sql.Out{Dest: &s}); !errors.Is(err, driver.ErrArrayBindNotSupported) {
+		t.Fatalf("got error %v - expected %v", err, driver.ErrArrayBindNotSupported)
+	}
+	var i int
+	if _, err := db.Exec(fmt.Sprintf("call %s(?, ?, ?)", proc), sql.Out{Dest: &i, In: true}, []int{1, 2}, sql.Out{Dest: &s}); !errors.Is(err, driver.ErrArrayBindNotSupported) {
+		t.Fatalf("got error %v - expected %v", err, driver.ErrArrayBindNotSupported)
+	}
+
+	// in arrays only: one call
+	table := driver.RandomIdentifier("tableArray_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer)", table)); err != nil {
+		t.Fatal(err)
+	}
+	proc = driver.RandomIdentifier("procInsert_")
+	if _, err := db.Exec(fmt.Sprintf(procInsert, proc, table)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("call %s(?, ?)", proc), []int64{1, 2, 3}, 0); err != nil {
+		t.Fatal(err)
+	}
+	// in arrays: array length mismatch
+	if _, err := db.Exec(fmt.Sprintf("call %s(?, ?)", proc), []int64{1, 2, 3}, []int{1, 2}); err == nil {
+		t.Fatal("array length mismatch error expected")
+	}
+	var sum int
+	if err := db.QueryRow(fmt.Sprintf("select sum(i) from %s", table)).Scan(&sum); err != nil {
+		t.Fatal(err)
+	}
+	if sum != 6 {
+		t.Fatalf("sum %d - expected %d", sum, 6)
+	}
+
+	// not supported: lob parameters
+	proc = driver.RandomIdentifier("procLob_")
+	if _, err := db.Exec(fmt.Sprintf("create procedure %s (in i integer, in b blob) language SQLSCRIPT as begin end", proc)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("call %s(?, ?)", proc), []int{1, 2}, []byte{0x42}); !errors.Is(err, driver.ErrArrayBindNotSupported) {
+		t.Fatalf("got error %v - expected %v", err, driver.ErrArrayBindNotSupported)
+	}
+}
+
+func testCallStruct(t *testing.T, db *sql.DB) {
+	const procIncrement = `create procedure %[1]s (inout i integer, in step integer, out s nvarchar(20))
+language SQLSCRIPT as
+begin
+	i := :i + :step;
+	s := 'value ' || to_nvarchar(:i);
+end
+`
+	proc := driver.RandomIdentifier("procStruct_")
+	if _, err := db.Exec(fmt.Sprintf(procIncrement, proc)); err != nil {
+		t.Fatal(err)
+	}
+	query := fmt.Sprintf("call %s(?, ?, ?)", proc)
+
+	// field order does not need to match the parameter order.
+	type params struct {
+		Text  string `sql:"S,,out"`
+		Value int    `sql:"I,,out"`
+		Step  int    `sql:"STEP"`
+		Other string `sql:"-"`
+	}
+
+	prms := &params{Value: 32, Step: 10}
+	if _, err := db.Exec(query, prms); err != nil {
+		t.Fatal(err)
+	}
+	if prms.Value != 42 || prms.Text != "value 42" {
+		t.Fatalf("got %v - expected %d %s", prms, 42, "value 42")
+	}
+
+	// invalid parameter name
+	type invParams struct {
+		I    int    `sql:"I,,out"`
+		Step int    `sql:"STEPS"`
+		S    string `sql:",,out"`
+	}
+	if _, err := db.Exec(query, &invParams{}); err == nil || !strings.Contains(err.Error(), "did you mean STEP?") {
+		t.Fatalf("got error %v - expected invalid parameter name error", err)
+	}
+
+	// output parameters require a pointer to struct
+	if _, err := db.Exec(query, params{}); err == nil {
+		t.Fatal("pointer to struct error expected")
+	}
+}
+
 func TestCall(t *testing.T) {
 	t.Parallel()
 
@@ -284,8 +535,12 @@ func TestCall(t *testing.T) {
 		{"echo", testCallEcho},
 		{"blobEcho", testCallBlobEcho},
 		{"tableOut", testCallTableOut},
+		{"unboundResults", testCallUnboundResults},
+		{"queryResults", testCallQueryResults},
 		{"noPrm", testCallNoPrm},
 		{"noOut", testCallNoOut},
+		{"array", testCallArray},
+		{"struct", testCallStruct},
 	}
 
 	db := driver.MT.DB()
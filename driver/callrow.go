@@ -0,0 +1,118 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+/*
+Stored procedures can return output values in two styles:
+  - as output parameters declared in the procedure signature (OUT / INOUT) which are bound via sql.Out arguments
+  - as result set (e.g. a select statement in the procedure body without output parameter assignment)
+
+Which style a procedure is using can be checked via the system view SYS.PROCEDURES
+(columns OUTPUT_PARAMETER_COUNT, INOUT_PARAMETER_COUNT and RESULT_SET_COUNT).
+
+ScanCallRow and StructScanner.ScanCallRow are helpers for procedures returning their output
+values as a single row result set.
+
+Procedures combining both styles can be executed via Query (table output parameters must not be bound
+as arguments in this case). Following the structure of the database server reply, the returned sql.Rows
+provide all result sets of the procedure (table output parameters and result sets) in procedure order,
+advancing via sql.Rows.NextResultSet. The scalar output parameters are assigned to their sql.Out
+destinations after the last result set is consumed or the rows are closed, so the destinations
+must not be read before.
+*/
+
+// callRows executes the procedure call query and returns the rows of the procedure result set.
+// Statement stmt needs to be kept open while reading the rows.
+func callRows(ctx context.Context, conn *sql.Conn, query string, args []any) (*sql.Stmt, *sql.Rows, error) {
+	stmt, err := conn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := new(sql.Rows)
+	if _, err := stmt.ExecContext(ctx, append(slices.Clip(args), sql.Out{Dest: rows})...); err != nil {
+		stmt.Close()
+		return nil, nil, err
+	}
+	return stmt, rows, nil
+}
+
+/*
+ScanCallRow executes the stored procedure call query with arguments args and scans the first
+row of the procedure result set into dest.
+If the result set is empty, sql.ErrNoRows is returned.
+
+The destinations are either assigned by position or, in case all destinations are provided
+as sql.NamedArg (e.g. sql.Named("COLUMN_NAME", &v)), by result set column name.
+*/
+func ScanCallRow(ctx context.Context, conn *sql.Conn, query string, args []any, dest ...any) error {
+	stmt, rows, err := callRows(ctx, conn, query, args)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	if err := scanNamed(rows, dest); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// ScanCallRow executes the stored procedure call query with arguments args and scans the first
+// row of the procedure result set into struct s of type *S.
+func (sc StructScanner[S]) ScanCallRow(ctx context.Context, conn *sql.Conn, query string, args []any, s *S) error {
+	stmt, rows, err := callRows(ctx, conn, query, args)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	return sc.ScanRow(rows, s)
+}
+
+// scanNamed scans the current row into dest either by position or by column name (sql.NamedArg).
+func scanNamed(rows *sql.Rows, dest []any) error {
+	numNamed := 0
+	for _, d := range dest {
+		if _, ok := d.(sql.NamedArg); ok {
+			numNamed++
+		}
+	}
+	if numNamed == 0 {
+		return rows.Scan(dest...)
+	}
+	if numNamed != len(dest) {
+		return errors.New("invalid destinations - mixing positional and named destinations is not supported")
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	values := make([]any, len(columns))
+	for _, d := range dest {
+		namedArg := d.(sql.NamedArg)
+		i := slices.Index(columns, namedArg.Name)
+		if i == -1 {
+			return fmt.Errorf("column for destination name %s not found", namedArg.Name)
+		}
+		values[i] = namedArg.Value
+	}
+	for i, v := range values {
+		if v == nil {
+			values[i] = new(any) // discard column value
+		}
+	}
+	return rows.Scan(values...)
+}
@@ -0,0 +1,80 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestScanCallRow(t *testing.T) {
+	t.Parallel()
+
+	const procRow = `create procedure %s (in i integer)
+language SQLSCRIPT reads sql data as
+begin
+    select :i as "I", 'Hello World!' as "TXT", :i * :i as "SQUARE" from dummy where :i > 0;
+end
+`
+	type callRow struct {
+		I      int
+		Txt    string `sql:"TXT"`
+		Square int    `sql:"SQUARE"`
+	}
+
+	ctx := context.Background()
+
+	conn, err := MT.DB().Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	proc := RandomIdentifier("procRow_")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf(procRow, proc)); err != nil {
+		t.Fatal(err)
+	}
+	query := fmt.Sprintf("call %s(?)", proc)
+
+	// positional destinations
+	var (
+		i, square int
+		txt       string
+	)
+	if err := ScanCallRow(ctx, conn, query, []any{7}, &i, &txt, &square); err != nil {
+		t.Fatal(err)
+	}
+	if i != 7 || txt != "Hello World!" || square != 49 {
+		t.Fatalf("got %d %s %d - expected %d %s %d", i, txt, square, 7, "Hello World!", 49)
+	}
+
+	// named destinations
+	square = 0
+	if err := ScanCallRow(ctx, conn, query, []any{8}, sql.Named("SQUARE", &square)); err != nil {
+		t.Fatal(err)
+	}
+	if square != 64 {
+		t.Fatalf("got %d - expected %d", square, 64)
+	}
+
+	// struct
+	scanner, err := NewStructScanner[callRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := new(callRow)
+	if err := scanner.ScanCallRow(ctx, conn, query, []any{9}, row); err != nil {
+		t.Fatal(err)
+	}
+	if *row != (callRow{I: 9, Txt: "Hello World!", Square: 81}) {
+		t.Fatalf("got %v - expected %v", *row, callRow{I: 9, Txt: "Hello World!", Square: 81})
+	}
+
+	// empty result set
+	if err := ScanCallRow(ctx, conn, query, []any{0}, &i, &txt, &square); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("got error %v - expected %s", err, sql.ErrNoRows)
+	}
+}
@@ -0,0 +1,84 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+)
+
+// CatalogTable represents the metadata of a database table (system view SYS.TABLES).
+type CatalogTable struct {
+	SchemaName  string         `sql:"SCHEMA_NAME"`
+	TableName   string         `sql:"TABLE_NAME"`
+	TableOID    ObjectID       `sql:"TABLE_OID"`
+	TableType   string         `sql:"TABLE_TYPE"` // COLUMN or ROW
+	IsTemporary bool           `sql:"IS_TEMPORARY"`
+	Comments    sql.NullString `sql:"COMMENTS"`
+}
+
+// CatalogColumn represents the metadata of a database table column (system view SYS.TABLE_COLUMNS).
+type CatalogColumn struct {
+	SchemaName   string         `sql:"SCHEMA_NAME"`
+	TableName    string         `sql:"TABLE_NAME"`
+	ColumnName   string         `sql:"COLUMN_NAME"`
+	ColumnID     ObjectID       `sql:"COLUMN_ID"`
+	Position     int            `sql:"POSITION"` // 1 based
+	DataTypeName string         `sql:"DATA_TYPE_NAME"`
+	Length       int64          `sql:"LENGTH"`
+	Scale        sql.NullInt64  `sql:"SCALE"`
+	IsNullable   bool           `sql:"IS_NULLABLE"`
+	DefaultValue sql.NullString `sql:"DEFAULT_VALUE"`
+	Comments     sql.NullString `sql:"COMMENTS"`
+}
+
+const (
+	catalogTablesQuery  = "select schema_name, table_name, table_oid, table_type, is_temporary, comments from sys.tables where schema_name = ? order by table_name"
+	catalogColumnsQuery = "select schema_name, table_name, column_name, column_id, position, data_type_name, length, scale, is_nullable, default_value, comments from sys.table_columns where schema_name = ? and table_name = ? order by position"
+)
+
+// Queryer is the interface wrapping the QueryContext method implemented by sql.DB, sql.Conn and sql.Tx.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Catalog provides access to the database catalog metadata via system views.
+type Catalog struct {
+	q Queryer
+}
+
+// NewCatalog returns a new Catalog instance querying the metadata via q.
+func NewCatalog(q Queryer) *Catalog { return &Catalog{q: q} }
+
+// Tables returns the tables of schema ordered by table name.
+func (c *Catalog) Tables(ctx context.Context, schema string) ([]CatalogTable, error) {
+	return queryCatalog[CatalogTable](ctx, c.q, catalogTablesQuery, schema)
+}
+
+// Columns returns the columns of table in schema ordered by column position.
+func (c *Catalog) Columns(ctx context.Context, schema, table string) ([]CatalogColumn, error) {
+	return queryCatalog[CatalogColumn](ctx, c.q, catalogColumnsQuery, schema, table)
+}
+
+func queryCatalog[S any](ctx context.Context, q Queryer, query string, args ...any) ([]S, error) {
+	scanner, err := NewStructScanner[S]()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []S
+	for rows.Next() {
+		var s S
+		if err := scanner.Scan(rows, &s); err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
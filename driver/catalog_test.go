@@ -0,0 +1,86 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	schema := RandomIdentifier("catalog_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create schema %s", schema)); err != nil {
+		t.Fatal(err)
+	}
+	defer db.ExecContext(ctx, fmt.Sprintf("drop schema %s cascade", schema)) //nolint:errcheck
+
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create column table %s.a (id integer not null, txt nvarchar(20) default 'x')", schema)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create row table %s.b (d decimal(10,2))", schema)); err != nil {
+		t.Fatal(err)
+	}
+
+	catalog := NewCatalog(db)
+
+	tables, err := catalog.Tables(ctx, string(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 2 {
+		t.Fatalf("number of tables %d - expected %d", len(tables), 2)
+	}
+	for i, table := range []struct{ name, typ string }{{"A", "COLUMN"}, {"B", "ROW"}} {
+		if tables[i].SchemaName != string(schema) || tables[i].TableName != table.name || tables[i].TableType != table.typ || tables[i].IsTemporary {
+			t.Fatalf("got table %v - expected %s %s", tables[i], table.name, table.typ)
+		}
+	}
+
+	// object ids in different representations
+	for _, expr := range []string{"object_oid", "to_nvarchar(object_oid)", "to_decimal(object_oid)"} {
+		var oid ObjectID
+		if err := db.QueryRowContext(ctx, fmt.Sprintf("select %s from sys.objects where schema_name = ? and object_name = ?", expr), string(schema), "A").Scan(&oid); err != nil {
+			t.Fatalf("%s: %s", expr, err)
+		}
+		if oid == 0 || oid != tables[0].TableOID {
+			t.Fatalf("%s: got object id %s - expected %s", expr, oid, tables[0].TableOID)
+		}
+	}
+
+	columns, err := catalog.Columns(ctx, string(schema), "A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 2 {
+		t.Fatalf("number of columns %d - expected %d", len(columns), 2)
+	}
+	if c := columns[0]; c.ColumnName != "ID" || c.ColumnID == 0 || c.Position != 1 || c.DataTypeName != "INTEGER" || c.IsNullable {
+		t.Fatalf("invalid column %v", c)
+	}
+	if c := columns[1]; c.ColumnName != "TXT" || c.Position != 2 || c.DataTypeName != "NVARCHAR" || c.Length != 20 || !c.IsNullable || c.DefaultValue.String != "x" {
+		t.Fatalf("invalid column %v", c)
+	}
+
+	columns, err = catalog.Columns(ctx, string(schema), "B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 1 || columns[0].DataTypeName != "DECIMAL" || columns[0].Length != 10 || columns[0].Scale.Int64 != 2 {
+		t.Fatalf("invalid columns %v", columns)
+	}
+
+	// not existing schema
+	tables, err = catalog.Tables(ctx, "' or '1' = '1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Fatalf("number of tables %d - expected %d", len(tables), 0)
+	}
+}
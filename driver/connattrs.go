@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -24,6 +25,15 @@ All defined session variables will be set once after a database connection is op
 */
 type SessionVariables map[string]string
 
+// ResultFormat represents the transfer format of result sets.
+type ResultFormat int
+
+// ResultFormat constants.
+const (
+	RowResultFormat      ResultFormat = iota // row wise result set transfer (default).
+	ColumnarResultFormat                     // column wise result set transfer.
+)
+
 // conn attributes default values.
 const (
 	defaultBufferSize   = 16276             // default value bufferSize.
@@ -47,6 +57,7 @@ const (
 
 const (
 	minFetchSize    = 1             // Minimal fetchSize value.
+	maxFetchSize    = math.MaxInt32 // Maximal fetchSize value.
 	minLobChunkSize = 128           // Minimal lobChunkSize
 	maxLobChunkSize = math.MaxInt32 // Maximal lobChunkSize
 )
@@ -72,6 +83,28 @@ type connAttrs struct {
 	_cesu8Encoder     func() transform.Transformer
 	_emptyDateAsNull  bool
 	_logger           *slog.Logger
+
+	_unknownTypeAsBytes bool
+	_trimChar           bool
+	_redirectHook       func(from, to string)
+	_maxOpenLobs        int
+	_lenientConversions bool
+	_resultFormat       ResultFormat
+	_nullNumericAsZero  bool
+	_debugReplyParts    bool
+	_statementTagger    func(ctx context.Context) string
+	_serverCancel       bool
+	_clientProduct      string
+	_clientVersion      string
+	_tlsClientAuth      bool
+	_applicationUser    string
+	_meter              Meter
+	_tracer             Tracer
+	_warningHandler     func(warning DBError)
+	_decimalRounding    bool
+	_statementFilter    func(query string) error
+	_timeLocation       *time.Location
+	_reconnectPolicy    ReconnectPolicy
 }
 
 func newConnAttrs() *connAttrs {
@@ -88,6 +121,8 @@ func newConnAttrs() *connAttrs {
 		_cesu8Decoder:    cesu8.DefaultDecoder,
 		_cesu8Encoder:    cesu8.DefaultEncoder,
 		_logger:          slog.Default(),
+		_clientProduct:   clientType,
+		_clientVersion:   DriverVersion,
 	}
 }
 
@@ -119,6 +154,28 @@ func (c *connAttrs) clone() *connAttrs {
 		_cesu8Encoder:     c._cesu8Encoder,
 		_emptyDateAsNull:  c._emptyDateAsNull,
 		_logger:           c._logger,
+
+		_unknownTypeAsBytes: c._unknownTypeAsBytes,
+		_trimChar:           c._trimChar,
+		_redirectHook:       c._redirectHook,
+		_maxOpenLobs:        c._maxOpenLobs,
+		_lenientConversions: c._lenientConversions,
+		_resultFormat:       c._resultFormat,
+		_nullNumericAsZero:  c._nullNumericAsZero,
+		_debugReplyParts:    c._debugReplyParts,
+		_statementTagger:    c._statementTagger,
+		_serverCancel:       c._serverCancel,
+		_clientProduct:      c._clientProduct,
+		_clientVersion:      c._clientVersion,
+		_tlsClientAuth:      c._tlsClientAuth,
+		_applicationUser:    c._applicationUser,
+		_meter:              c._meter,
+		_tracer:             c._tracer,
+		_warningHandler:     c._warningHandler,
+		_decimalRounding:    c._decimalRounding,
+		_statementFilter:    c._statementFilter,
+		_timeLocation:       c._timeLocation,
+		_reconnectPolicy:    c._reconnectPolicy,
 	}
 }
 
@@ -326,6 +383,23 @@ func (c *connAttrs) SetApplicationName(name string) {
 	c._applicationName = name
 }
 
+// ApplicationUser returns the application user of the connector.
+func (c *connAttrs) ApplicationUser() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._applicationUser
+}
+
+// SetApplicationUser sets the application user of the connector.
+// The application user is the end user an application executes the database requests for
+// (e.g. in multi-tenant servers). It is sent as session variable APPLICATIONUSER on connect
+// and can be changed per connection via SetApplicationUser.
+func (c *connAttrs) SetApplicationUser(user string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._applicationUser = user
+}
+
 // SessionVariables returns the session variables stored in connector.
 func (c *connAttrs) SessionVariables() SessionVariables {
 	c.mu.RLock()
@@ -340,6 +414,22 @@ func (c *connAttrs) SetSessionVariables(sessionVariables SessionVariables) {
 	c._sessionVariables = maps.Clone(sessionVariables)
 }
 
+// clientInfo returns the session variables sent as client info on connect: the application name and
+// the application user are sent as session variables APPLICATION and APPLICATIONUSER if not set explicitly.
+func (c *connAttrs) clientInfo() map[string]string {
+	clientInfo := maps.Clone(c._sessionVariables)
+	if clientInfo == nil {
+		clientInfo = map[string]string{}
+	}
+	if _, ok := clientInfo[svApplication]; !ok && c._applicationName != "" {
+		clientInfo[svApplication] = c._applicationName
+	}
+	if _, ok := clientInfo[svApplicationUser]; !ok && c._applicationUser != "" {
+		clientInfo[svApplicationUser] = c._applicationUser
+	}
+	return clientInfo
+}
+
 // Locale returns the locale of the connector.
 func (c *connAttrs) Locale() string { c.mu.RLock(); defer c.mu.RUnlock(); return c._locale }
 
@@ -377,7 +467,10 @@ func (c *connAttrs) SetLobChunkSize(lobChunkSize int) {
 // Dfv returns the client data format version of the connector.
 func (c *connAttrs) Dfv() int { c.mu.RLock(); defer c.mu.RUnlock(); return c._dfv }
 
-// SetDfv sets the client data format version of the connector.
+/*
+SetDfv sets the client data format version of the connector.
+The REAL_VECTOR data type (scanned into and bound from []float32 values, NullRealVector for nullable fields) requires data format version 10.
+*/
 func (c *connAttrs) SetDfv(dfv int) { c.mu.Lock(); defer c.mu.Unlock(); c.setDfv(dfv) }
 
 // CESU8Decoder returns the CESU-8 decoder of the connector.
@@ -437,6 +530,406 @@ func (c *connAttrs) SetEmptyDateAsNull(emptyDateAsNull bool) {
 	c._emptyDateAsNull = emptyDateAsNull
 }
 
+/*
+UnknownTypeAsBytes returns the raw field bytes for data types unknown to the driver if true, otherwise
+reading fields of unknown data types fails (default) with a decode error of the affected rows.
+The error contains the type code only, as the database protocol does not provide the type name
+(which can be looked up in the database catalog, e.g. the DATA_TYPE_NAME column of the TABLE_COLUMNS view).
+
+Enabling this option allows applications to keep working when connecting to newer database versions
+providing additional data types.
+*/
+func (c *connAttrs) UnknownTypeAsBytes() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._unknownTypeAsBytes
+}
+
+// SetUnknownTypeAsBytes sets the UnknownTypeAsBytes flag of the connector.
+func (c *connAttrs) SetUnknownTypeAsBytes(unknownTypeAsBytes bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._unknownTypeAsBytes = unknownTypeAsBytes
+}
+
+/*
+TrimChar returns values of fixed length character columns (CHAR, NCHAR) with trailing blanks removed if true, otherwise
+the values are returned unchanged including the blank padding (default).
+
+Variable length character columns (VARCHAR, NVARCHAR, ...) are not affected.
+*/
+func (c *connAttrs) TrimChar() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._trimChar
+}
+
+// SetTrimChar sets the TrimChar flag of the connector.
+func (c *connAttrs) SetTrimChar(trimChar bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._trimChar = trimChar
+}
+
+// RedirectHook returns the redirect hook function of the connector.
+func (c *connAttrs) RedirectHook() func(from, to string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._redirectHook
+}
+
+/*
+SetRedirectHook sets the redirect hook function of the connector.
+The hook is called with the previous host (from) and the database host (to) whenever a tenant database connection
+is established to a different host than the previous connection of the connector (initially the connector host),
+which is the case for the tenant database redirect and for reconnects after a failover of the tenant database.
+*/
+func (c *connAttrs) SetRedirectHook(redirectHook func(from, to string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._redirectHook = redirectHook
+}
+
+/*
+MaxOpenLobs returns the maximum number of open lobs per connection.
+A lob is open if its content is not yet read completely from the database server (lob locator).
+Zero means no limit.
+*/
+func (c *connAttrs) MaxOpenLobs() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._maxOpenLobs
+}
+
+/*
+SetMaxOpenLobs sets the maximum number of open lobs per connection.
+Fetching a row exceeding this limit returns an error, so that lobs need to be read before further rows can be fetched.
+If maxOpenLobs <= 0, there is no limit on the number of open lobs (default).
+*/
+func (c *connAttrs) SetMaxOpenLobs(maxOpenLobs int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._maxOpenLobs = max(maxOpenLobs, 0)
+}
+
+// LenientConversions returns the LenientConversions flag of the connector.
+func (c *connAttrs) LenientConversions() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._lenientConversions
+}
+
+/*
+SetLenientConversions sets the LenientConversions flag of the connector.
+If set, parameter values of compatible types are converted to numeric database types as long as the
+conversion is lossless (e.g. a decimal or a string like "42.0" to INTEGER or an integral decimal to DOUBLE).
+Lossy conversions (e.g. 1.5 to INTEGER) are rejected in any case.
+By default (false) only the standard conversions are applied.
+*/
+func (c *connAttrs) SetLenientConversions(lenientConversions bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._lenientConversions = lenientConversions
+}
+
+// DecimalRounding returns the DecimalRounding flag of the connector.
+func (c *connAttrs) DecimalRounding() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._decimalRounding
+}
+
+/*
+SetDecimalRounding sets the DecimalRounding flag of the connector.
+By default (false) decimal parameter values which cannot be represented within the precision and scale of the
+database field (e.g. 1.235 for a DECIMAL(10,2) field or more than 16 significant digits for a SMALLDECIMAL field)
+are rejected with an error. Floating point values (float32, float64) are not affected, as their binary
+representation is rarely exact in decimal scale (e.g. 0.1) - they are always rounded half-to-even to the field scale.
+If set, values exceeding the scale are rounded half-to-even (e.g. 1.235 to 1.24 and 1.245 to 1.24) instead.
+Values exceeding the precision are rejected in any case.
+*/
+func (c *connAttrs) SetDecimalRounding(decimalRounding bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._decimalRounding = decimalRounding
+}
+
+// TimeLocation returns the location date and timestamp values are stored in (nil: UTC).
+func (c *connAttrs) TimeLocation() *time.Location {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._timeLocation
+}
+
+/*
+SetTimeLocation sets the location date and timestamp values are stored in.
+The database date and timestamp types (DATE / DAYDATE, TIMESTAMP / LONGDATE, SECONDDATE) are timezone-naive,
+meaning that they store a wall clock time without location information.
+
+By default (nil) time.Time parameter values are converted to UTC before the wall clock time is stored and
+column values are returned as time.Time values in UTC.
+
+If set, time.Time parameter values are converted to location loc before the wall clock time is stored
+(e.g. 2024-01-01 12:00:00 +0000 UTC is stored as 2024-01-01 13:00:00 for location Europe/Berlin) and
+column values are returned as time.Time values in location loc with the stored wall clock time.
+
+Please note that
+  - TIME / SECONDTIME values are not affected (time of day without date)
+  - a stored wall clock time which does not exist in location loc (daylight saving time gap) is normalized by time.Date
+*/
+func (c *connAttrs) SetTimeLocation(loc *time.Location) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._timeLocation = loc
+}
+
+// convertOptions returns the parameter conversion options.
+func (c *connAttrs) convertOptions() p.ConvertOptions {
+	return p.ConvertOptions{Lenient: c._lenientConversions, RoundDecimal: c._decimalRounding, Location: c._timeLocation}
+}
+
+// ResultFormat returns the requested result set transfer format of the connector.
+func (c *connAttrs) ResultFormat() ResultFormat {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._resultFormat
+}
+
+/*
+SetResultFormat sets the requested result set transfer format of the connector.
+ColumnarResultFormat can be more efficient for wide analytic result sets.
+The format is requested when a connection is established and only used if accepted by the database server,
+otherwise results are transferred row wise (default).
+*/
+func (c *connAttrs) SetResultFormat(format ResultFormat) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._resultFormat = format
+}
+
+/*
+NullNumericAsZero returns true if NULL values of numeric and temporal database types are returned as Go zero
+values (0, 0.0, zero decimal, zero time.Time), so that they can be scanned into non-pointer Go types.
+By default (false) NULL values are returned as nil and scanning them into non-pointer Go types does fail.
+
+Please note that NULL and zero values cannot be distinguished anymore if set. Please use sql.Null types
+or pointer types instead if possible - this option is intended to ease the migration from drivers with
+looser NULL semantics.
+*/
+func (c *connAttrs) NullNumericAsZero() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._nullNumericAsZero
+}
+
+// SetNullNumericAsZero sets the NullNumericAsZero flag of the connector.
+func (c *connAttrs) SetNullNumericAsZero(nullNumericAsZero bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._nullNumericAsZero = nullNumericAsZero
+}
+
+// DebugReplyParts returns true if the parts of the last database server reply are recorded for debugging purposes (see Conn.LastReplyParts).
+func (c *connAttrs) DebugReplyParts() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._debugReplyParts
+}
+
+// SetDebugReplyParts sets the DebugReplyParts flag of the connector.
+// Recording the reply parts adds a small overhead to each database server round-trip and should
+// only be enabled for diagnosing unexpected database server behavior.
+func (c *connAttrs) SetDebugReplyParts(debugReplyParts bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._debugReplyParts = debugReplyParts
+}
+
+// StatementTagger returns the statement tagger function of the connector.
+func (c *connAttrs) StatementTagger() func(ctx context.Context) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._statementTagger
+}
+
+// SetStatementTagger sets a function which is called with the context of each statement sent to the database server
+// (execute direct and prepare). A non-empty tag returned by the function is prepended to the statement as a comment
+// so that the statement can be correlated with application requests e.g. in M_SQL_PLAN_CACHE or in database server traces.
+//
+// Please note that
+//   - the tag is only attached to the statement text sent to the database server - placeholders are not affected
+//   - a comment terminating sequence in the tag is escaped so that the tag cannot terminate the comment
+//   - statements with different tags are different statements for the database server plan cache
+func (c *connAttrs) SetStatementTagger(statementTagger func(ctx context.Context) string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._statementTagger = statementTagger
+}
+
+// StatementFilter returns the statement filter function of the connector.
+func (c *connAttrs) StatementFilter() func(query string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._statementFilter
+}
+
+// SetStatementFilter sets a function which is called with each statement before it is sent to the database server
+// (execute direct and prepare). In case the function returns an error, the statement is rejected without a database
+// server round-trip and the error is returned wrapped to the caller, e.g. to block DDL statements in read-mostly services.
+//
+// Please note that
+//   - the function is called with the statement text as sent, so after any rewriting (hints, statement tags)
+//   - the function is called for driver internal statements as well (e.g. ping or transaction isolation level statements)
+//   - prepared statements are filtered once at prepare time and not on each execution
+func (c *connAttrs) SetStatementFilter(statementFilter func(query string) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._statementFilter = statementFilter
+}
+
+// ReconnectPolicy returns the reconnect policy of the connector.
+func (c *connAttrs) ReconnectPolicy() ReconnectPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._reconnectPolicy
+}
+
+/*
+SetReconnectPolicy sets the reconnect policy of the connector (disabled by default), e.g.
+
+	connector.SetReconnectPolicy(driver.ReconnectPolicy{MaxAttempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: 5 * time.Second})
+
+If set, in case of a connection error (see IsConnectionError)
+  - establishing a new connection (including the authentication) is retried with backoff according to the policy and
+  - a select query executed outside of a transaction fails with an error wrapping driver.ErrBadConn, so that
+    database/sql executes the query again on a new connection (sql.DB and sql.Stmt only).
+
+Please note that
+  - statements within a transaction are never retried to avoid duplicate effects - the error is returned and
+    the transaction needs to be rolled back and executed again by the application
+  - execs, procedure calls and other statements executed via Query (e.g. DML) are only retried if the request
+    was not sent to the database server, as the database server might have executed the statement already
+    before the connection got lost
+*/
+func (c *connAttrs) SetReconnectPolicy(policy ReconnectPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._reconnectPolicy = policy
+}
+
+// ServerCancel returns true if statements are cancelled on the database server in case the context of a db call is done.
+func (c *connAttrs) ServerCancel() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._serverCancel
+}
+
+// SetServerCancel sets the ServerCancel flag of the connector.
+// By default a db call returns as soon as its context is done, but the database server continues executing the
+// statement until completion. With ServerCancel set, the statement is additionally cancelled on the database server
+// (ALTER SYSTEM CANCEL WORK IN SESSION) via a separate short-lived connection to free the database server resources.
+//
+// Please note that
+//   - the connection id of each connection is queried once on connect, which adds a database server round-trip
+//   - the user needs to be allowed to cancel the work of its own sessions (e.g. privilege SESSION ADMIN)
+//   - a statement completing in the meantime is not affected, as the cancelled connection is not used anymore
+func (c *connAttrs) SetServerCancel(serverCancel bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._serverCancel = serverCancel
+}
+
+// ClientProduct returns the client product name and version provided to the database server on connect.
+func (c *connAttrs) ClientProduct() (string, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._clientProduct, c._clientVersion
+}
+
+// SetClientProduct sets the client product name and version provided to the database server on connect
+// (client context), so that sessions of wrapping libraries can be distinguished e.g. in M_SESSION_CONTEXT.
+// An empty name or version falls back to the default values, which are the go-hdb client type and DriverVersion.
+func (c *connAttrs) SetClientProduct(name, version string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if name == "" {
+		name = clientType
+	}
+	if version == "" {
+		version = DriverVersion
+	}
+	c._clientProduct, c._clientVersion = name, version
+}
+
+// TLSClientAuth returns true if the X509 authentication client certificate is presented in the TLS handshake.
+func (c *connAttrs) TLSClientAuth() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._tlsClientAuth
+}
+
+// SetTLSClientAuth sets the TLSClientAuth flag of the connector.
+// With TLSClientAuth set, the X509 authentication client certificate (see NewX509AuthConnector) is presented
+// to the database server in the TLS handshake (mutual TLS) in addition to be used for the X509 authentication.
+// The certificate is validated (validity period and certificate chain) before the handshake, so that an
+// invalid certificate is reported as certificate error (see IsCertificateInvalid) instead of a failed
+// handshake or authentication.
+//
+// Please note that a TLS configuration is needed (see SetTLSConfig) for the certificate to be presented.
+func (c *connAttrs) SetTLSClientAuth(tlsClientAuth bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._tlsClientAuth = tlsClientAuth
+}
+
+// Meter returns the Meter of the connector.
+func (c *connAttrs) Meter() Meter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._meter
+}
+
+// SetMeter sets the Meter of the connector. With a Meter set, the driver records operation latencies,
+// fetched rows, transferred bytes, errors by class and the number of open connections (see Meter).
+// A nil Meter (default) disables recording.
+func (c *connAttrs) SetMeter(meter Meter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._meter = meter
+}
+
+// Tracer returns the Tracer of the connector.
+func (c *connAttrs) Tracer() Tracer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._tracer
+}
+
+// SetTracer sets the Tracer of the connector. With a Tracer set, the driver starts a span for each
+// query, exec, prepare and procedure call (see Tracer). A nil Tracer (default) disables tracing.
+func (c *connAttrs) SetTracer(tracer Tracer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._tracer = tracer
+}
+
+// WarningHandler returns the warning handler of the connector.
+func (c *connAttrs) WarningHandler() func(warning DBError) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c._warningHandler
+}
+
+// SetWarningHandler sets the warning handler of the connector. Database server warnings (e.g. returned by
+// ALTER SYSTEM admin statements or procedures) do not fail the statement and are logged by default.
+// With a warning handler set, the handler is called for each warning instead. The handler is called
+// synchronously on the database call path of the connection and should not block.
+func (c *connAttrs) SetWarningHandler(handler func(warning DBError)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c._warningHandler = handler
+}
+
 // Logger returns the Logger instance of the connector.
 func (c *connAttrs) Logger() *slog.Logger {
 	c.mu.RLock()
@@ -25,6 +25,7 @@ import (
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 	hdbreflect "github.com/SAP/go-hdb/driver/internal/reflect"
 	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+	textencoding "golang.org/x/text/encoding"
 	"golang.org/x/text/transform"
 )
 
@@ -41,6 +42,11 @@ var ErrNestedTransaction = errors.New("nested transactions are not supported")
 // This error can be avoided in whether using a transaction or a dedicated connection (sql.Tx or sql.Conn).
 var ErrNestedQuery = errors.New("nested sql queries are not supported")
 
+// ErrConcurrentUse is the error raised if a sql statement is sent to the database server while another statement
+// of the same connection is still in progress.
+// A connection must not be used concurrently, e.g. by sharing the driver connection obtained via sql.Conn.Raw between goroutines.
+var ErrConcurrentUse = errors.New("concurrent use of connection")
+
 // queries.
 const (
 	dummyQuery                      = "select 1 from dummy"
@@ -57,26 +63,76 @@ var (
 	_ = p.RegisterScanType(p.DtBytes, hdbreflect.TypeFor[[]byte](), hdbreflect.TypeFor[NullBytes]())
 	_ = p.RegisterScanType(p.DtDecimal, hdbreflect.TypeFor[Decimal](), hdbreflect.TypeFor[NullDecimal]())
 	_ = p.RegisterScanType(p.DtLob, hdbreflect.TypeFor[Lob](), hdbreflect.TypeFor[NullLob]())
+	_ = p.RegisterScanType(p.DtRealVector, hdbreflect.TypeFor[[]float32](), hdbreflect.TypeFor[NullRealVector]())
 )
 
 // dbConn wraps the database tcp connection. It sets timeouts and handles driver ErrBadConn behavior.
 type dbConn struct {
 	metrics   *metrics
+	meter     Meter
 	conn      net.Conn
 	timeout   time.Duration
 	logger    *slog.Logger
 	lastRead  time.Time
 	lastWrite time.Time
+	// number of transferred bytes
+	bytesRead    atomic.Uint64
+	bytesWritten atomic.Uint64
+	// connection setup (handshake) context
+	ctx  context.Context
+	stop func() bool
+}
+
+// aLongTimeAgo is a non-zero time, far in the past, used for immediate cancellation of network operations.
+var aLongTimeAgo = time.Unix(1, 0)
+
+/*
+startHandshake applies the deadline and the cancellation of ctx to the database connection
+until endHandshake is called, so that all round-trips of the connection setup (prolog, authentication)
+are bound to ctx. It is used for bulk execs as well to abort a package being written on cancellation.
+*/
+func (c *dbConn) startHandshake(ctx context.Context) {
+	c.ctx = ctx
+	c.stop = context.AfterFunc(ctx, func() { c.conn.SetDeadline(aLongTimeAgo) }) //nolint:errcheck
+}
+
+func (c *dbConn) endHandshake() {
+	if c.stop != nil {
+		c.stop()
+	}
+	c.ctx, c.stop = nil, nil
+}
+
+// ctxErr returns the error of the handshake context.
+func (c *dbConn) ctxErr() error {
+	if c.ctx == nil {
+		return nil
+	}
+	return c.ctx.Err()
 }
 
 func (c *dbConn) deadline() (deadline time.Time) {
-	if c.timeout == 0 {
+	if c.timeout != 0 {
+		deadline = time.Now().Add(c.timeout)
+	}
+	if c.ctx == nil {
 		return
 	}
-	return time.Now().Add(c.timeout)
+	if ctxDeadline, ok := c.ctx.Deadline(); ok && (deadline.IsZero() || ctxDeadline.Before(deadline)) {
+		return ctxDeadline
+	}
+	return
 }
 
-func (c *dbConn) close() error { return c.conn.Close() }
+// bytes returns the number of bytes read from and written to the database connection.
+func (c *dbConn) bytes() TxBytes {
+	return TxBytes{Read: c.bytesRead.Load(), Written: c.bytesWritten.Load()}
+}
+
+func (c *dbConn) close() error {
+	c.endHandshake()
+	return c.conn.Close()
+}
 
 // Read implements the io.Reader interface.
 func (c *dbConn) Read(b []byte) (int, error) {
@@ -84,10 +140,17 @@ func (c *dbConn) Read(b []byte) (int, error) {
 	if err := c.conn.SetReadDeadline(c.deadline()); err != nil {
 		return 0, fmt.Errorf("%w: %w", driver.ErrBadConn, err)
 	}
+	if err := c.ctxErr(); err != nil { // check after setting the deadline (see startHandshake)
+		return 0, fmt.Errorf("%w: %w", driver.ErrBadConn, err)
+	}
 	c.lastRead = time.Now()
 	n, err := c.conn.Read(b)
 	c.metrics.msgCh <- timeMsg{idx: timeRead, d: time.Since(c.lastRead)}
 	c.metrics.msgCh <- counterMsg{idx: counterBytesRead, v: uint64(n)}
+	c.bytesRead.Add(uint64(n))
+	if c.meter != nil {
+		c.meter.Add(context.Background(), MeterBytesRead, int64(n))
+	}
 	if err != nil {
 		c.logger.LogAttrs(context.Background(), slog.LevelError, "DB conn read error", slog.String("error", err.Error()), slog.String("local address", c.conn.LocalAddr().String()), slog.String("remote address", c.conn.RemoteAddr().String()))
 		// wrap error in driver.ErrBadConn
@@ -102,10 +165,17 @@ func (c *dbConn) Write(b []byte) (int, error) {
 	if err := c.conn.SetWriteDeadline(c.deadline()); err != nil {
 		return 0, fmt.Errorf("%w: %w", driver.ErrBadConn, err)
 	}
+	if err := c.ctxErr(); err != nil { // check after setting the deadline (see startHandshake)
+		return 0, fmt.Errorf("%w: %w", driver.ErrBadConn, err)
+	}
 	c.lastWrite = time.Now()
 	n, err := c.conn.Write(b)
 	c.metrics.msgCh <- timeMsg{idx: timeWrite, d: time.Since(c.lastWrite)}
 	c.metrics.msgCh <- counterMsg{idx: counterBytesWritten, v: uint64(n)}
+	c.bytesWritten.Add(uint64(n))
+	if c.meter != nil {
+		c.meter.Add(context.Background(), MeterBytesWritten, int64(n))
+	}
 	if err != nil {
 		c.logger.LogAttrs(context.Background(), slog.LevelError, "DB conn write error", slog.String("error", err.Error()), slog.String("local address", c.conn.LocalAddr().String()), slog.String("remote address", c.conn.RemoteAddr().String()))
 		// wrap error in driver.ErrBadConn
@@ -144,6 +214,41 @@ type Conn interface {
 	HDBVersion() *Version
 	DatabaseName() string
 	DBConnectInfo(ctx context.Context, databaseName string) (*DBConnectInfo, error)
+	ResetTransaction(ctx context.Context) error
+	TxBytes() TxBytes
+	LastReplyParts() []ReplyPart
+	Stats() ConnStats
+}
+
+// ReplyPart represents a part of a database server protocol reply (see Conn.LastReplyParts).
+type ReplyPart struct {
+	Kind   string // part kind (e.g. PkResultset, PkRowsAffected, PkError, PkParameterMetadata)
+	NumArg int    // number of part arguments (e.g. number of rows of a result set)
+}
+
+// TxBytes represents the number of bytes transferred between client and database server within a transaction.
+type TxBytes struct {
+	Read    uint64 // number of bytes read from the database server
+	Written uint64 // number of bytes written to the database server
+}
+
+// ConnStats represents a snapshot of the statistics of a database connection (see Conn.Stats).
+type ConnStats struct {
+	ReadBytes       uint64 // number of bytes read from the database server
+	WrittenBytes    uint64 // number of bytes written to the database server
+	RoundTrips      uint64 // number of database server round-trips (request and reply)
+	Prepares        uint64 // number of prepared statements
+	OpenStatements  int64  // number of open statements
+	LobReadBytes    uint64 // number of lob bytes read
+	LobWrittenBytes uint64 // number of lob bytes written
+}
+
+// connStats holds the statistics counters of a connection not tracked by dbConn or the protocol writer.
+type connStats struct {
+	numPrepare      atomic.Uint64
+	numOpenStmt     atomic.Int64
+	lobBytesRead    atomic.Uint64
+	lobBytesWritten atomic.Uint64
 }
 
 var stdConnTracker = &connTracker{}
@@ -190,13 +295,24 @@ type conn struct {
 	dbConn *dbConn
 
 	wg        sync.WaitGroup // wait for concurrent db calls when closing connections
+	inUse     atomic.Bool    // db call in progress
 	inTx      bool           // in transaction
 	lastError error          // last error
 	sessionID int64
 
+	connectionID  int64                                          // connection id (server cancel only)
+	cancelConnect func(ctx context.Context) (driver.Conn, error) // connects cancel connections (server cancel only)
+
+	txStartBytes, txEndBytes TxBytes // transferred bytes at transaction start and end
+	stats                    connStats
+
 	serverOptions *p.ConnectOptions
 	hdbVersion    *Version
 
+	clientInfo     map[string]string        // session variables sent as client info on connect
+	appContextKeys map[string]struct{}      // session context variables set by SetAppContext
+	openLobs       map[p.LocatorID]struct{} // lob locators which are not read completely
+
 	dec *encoding.Decoder
 	pr  *p.Reader
 	pw  *p.Writer
@@ -216,6 +332,29 @@ func isAuthError(err error) bool {
 }
 
 func connect(ctx context.Context, host string, metrics *metrics, connAttrs *connAttrs, authAttrs *authAttrs) (driver.Conn, error) {
+	driverConn, err := connectSession(ctx, host, metrics, connAttrs, authAttrs)
+	if err != nil && connAttrs._meter != nil {
+		meterError(ctx, connAttrs._meter, err)
+	}
+	if err != nil || !connAttrs._serverCancel {
+		return driverConn, err
+	}
+	c := driverConn.(*conn)
+	if c.connectionID, err = c.queryConnectionID(ctx); err != nil {
+		c.Close()
+		if connAttrs._meter != nil {
+			meterError(ctx, connAttrs._meter, err)
+		}
+		return nil, err
+	}
+	// cancel connections do not need server cancellation themselves.
+	c.cancelConnect = func(ctx context.Context) (driver.Conn, error) {
+		return connectSession(ctx, host, metrics, connAttrs, authAttrs)
+	}
+	return c, nil
+}
+
+func connectSession(ctx context.Context, host string, metrics *metrics, connAttrs *connAttrs, authAttrs *authAttrs) (driver.Conn, error) {
 	// can we connect via cookie?
 	if auth := authAttrs.cookieAuth(); auth != nil {
 		conn, err := newSession(ctx, host, metrics, connAttrs, auth)
@@ -230,7 +369,10 @@ func connect(ctx context.Context, host string, metrics *metrics, connAttrs *conn
 
 	lastVersion := authAttrs.version.Load()
 	for {
-		authHnd := authAttrs.authHnd()
+		authHnd, err := authAttrs.authHnd(ctx)
+		if err != nil {
+			return nil, err
+		}
 
 		conn, err := newSession(ctx, host, metrics, connAttrs, authHnd)
 		if err == nil {
@@ -296,7 +438,8 @@ func newConn(ctx context.Context, host string, metrics *metrics, attrs *connAttr
 
 	logger := attrs._logger.With(slog.Uint64("conn", connNo.Add(1)))
 
-	dbConn := &dbConn{metrics: metrics, conn: netConn, timeout: attrs._timeout, logger: logger}
+	dbConn := &dbConn{metrics: metrics, meter: attrs._meter, conn: netConn, timeout: attrs._timeout, logger: logger}
+	dbConn.startHandshake(ctx) // ended by caller after connection setup
 	// buffer connection
 	rw := bufio.NewReadWriter(bufio.NewReaderSize(dbConn, attrs._bufferSize), bufio.NewWriterSize(dbConn, attrs._bufferSize))
 
@@ -305,34 +448,57 @@ func newConn(ctx context.Context, host string, metrics *metrics, attrs *connAttr
 	enc := encoding.NewEncoder(rw.Writer, attrs._cesu8Encoder)
 	dec := encoding.NewDecoder(rw.Reader, attrs._cesu8Decoder)
 
+	clientInfo := attrs.clientInfo()
+
 	c := &conn{
-		attrs:     attrs,
-		metrics:   metrics,
-		dbConn:    dbConn,
-		sqlTrace:  sqlTrace.Load(),
-		logger:    logger,
-		dec:       dec,
-		pw:        p.NewWriter(rw.Writer, enc, protTrace, logger, attrs._cesu8Encoder, attrs._sessionVariables), // write upstream
-		pr:        p.NewDBReader(dec, protTrace, logger),                                                        // read downstream
-		sessionID: defaultSessionID,
+		attrs:      attrs,
+		clientInfo: clientInfo,
+		metrics:    metrics,
+		dbConn:     dbConn,
+		sqlTrace:   sqlTrace.Load(),
+		logger:     logger,
+		dec:        dec,
+		pw:         p.NewWriter(rw.Writer, enc, protTrace, logger, attrs._cesu8Encoder, clientInfo), // write upstream
+		pr:         p.NewDBReader(dec, protTrace, logger),                                           // read downstream
+		sessionID:  defaultSessionID,
+	}
+
+	c.pr.SetRecordParts(attrs._debugReplyParts)
+	if handler := attrs._warningHandler; handler != nil {
+		c.pr.SetWarningHandler(func(warning *p.HdbError) { handler(warning) })
 	}
 
 	if err := c.pw.WriteProlog(ctx); err != nil {
 		dbConn.close()
-		return nil, err
+		return nil, handshakeError(ctx, err)
 	}
 
 	if err := c.pr.ReadProlog(ctx); err != nil {
 		dbConn.close()
-		return nil, err
+		return nil, handshakeError(ctx, err)
 	}
 
 	stdConnTracker.add()
 
 	c.metrics.msgCh <- gaugeMsg{idx: gaugeConn, v: 1} // increment open connections.
+	if attrs._meter != nil {
+		attrs._meter.Add(ctx, MeterConnections, 1)
+	}
 	return c, nil
 }
 
+// handshakeError returns the context error in case the connection setup was cancelled or the context deadline was exceeded.
+func handshakeError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	// network deadline might be exceeded before the context deadline timer fires
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
 func fetchRedirectHost(ctx context.Context, host, databaseName string, metrics *metrics, attrs *connAttrs) (string, error) {
 	c, err := newConn(ctx, host, metrics, attrs)
 	if err != nil {
@@ -356,8 +522,9 @@ func newSession(ctx context.Context, host string, metrics *metrics, attrs *connA
 	}
 	if err := c.initSession(ctx, attrs, authHnd); err != nil {
 		c.Close()
-		return nil, err
+		return nil, handshakeError(ctx, err)
 	}
+	c.dbConn.endHandshake()
 	return c, nil
 }
 
@@ -372,6 +539,11 @@ func (c *conn) initSession(ctx context.Context, attrs *connAttrs, authHnd *p.Aut
 	c.hdbVersion = parseVersion(c.versionString())
 	c.dec.SetAlphanumDfv1(c.serverOptions.DataFormatVersion2OrZero() == p.DfvLevel1)
 	c.dec.SetEmptyDateAsNull(attrs._emptyDateAsNull)
+	c.dec.SetUnknownTypeAsBytes(attrs._unknownTypeAsBytes)
+	c.dec.SetTrimChar(attrs._trimChar)
+	c.dec.SetLocation(attrs._timeLocation)
+	c.dec.SetNullNumericAsZero(attrs._nullNumericAsZero)
+	c.dec.SetColumnarResultSet(attrs._resultFormat == ColumnarResultFormat && c.serverOptions.ColumnarResultSetOrZero())
 
 	if attrs._defaultSchema != "" {
 		if _, err := c.ExecContext(ctx, strings.Join([]string{setDefaultSchema, Identifier(attrs._defaultSchema).String()}, " "), nil); err != nil {
@@ -389,7 +561,17 @@ func (c *conn) ResetSession(ctx context.Context) error {
 		return driver.ErrBadConn
 	}
 
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
 	c.lastError = nil
+	clear(c.openLobs)
+
+	if err := c.resetAppContext(ctx); err != nil {
+		return driver.ErrBadConn
+	}
 
 	if c.attrs._pingInterval == 0 || c.dbConn.lastRead.IsZero() || time.Since(c.dbConn.lastRead) < c.attrs._pingInterval {
 		return nil
@@ -401,7 +583,20 @@ func (c *conn) ResetSession(ctx context.Context) error {
 	return nil
 }
 
-func (c *conn) isBad() bool { return errors.Is(c.lastError, driver.ErrBadConn) }
+// lock marks the connection as in use and returns ErrConcurrentUse if a db call is already in progress.
+func (c *conn) lock() error {
+	if !c.inUse.CompareAndSwap(false, true) {
+		return ErrConcurrentUse
+	}
+	return nil
+}
+
+// unlock marks the connection as not in use.
+func (c *conn) unlock() { c.inUse.Store(false) }
+
+func (c *conn) isBad() bool {
+	return errors.Is(c.lastError, driver.ErrBadConn) || IsSessionInvalidated(c.lastError)
+}
 
 // IsValid implements the driver.Validator interface.
 func (c *conn) IsValid() bool { return !c.isBad() }
@@ -412,21 +607,26 @@ func (c *conn) Ping(ctx context.Context) error {
 		defer c.logSQLTrace(ctx, time.Now(), dummyQuery, nil)
 	}
 
+	if err := c.lock(); err != nil {
+		return err
+	}
+
 	done := make(chan struct{})
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
+		defer c.unlock()
 		_, err = c.queryDirect(ctx, dummyQuery, !c.inTx)
 		close(done)
 	}()
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.setLastError(ctx, errCancelled)
 		return ctx.Err()
 	case <-done:
-		c.lastError = err
+		c.setLastError(ctx, err)
 		return err
 	}
 }
@@ -437,12 +637,17 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, e
 		defer c.logSQLTrace(ctx, time.Now(), query, nil)
 	}
 
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+
 	done := make(chan struct{})
 	var stmt driver.Stmt
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
+		defer c.unlock()
 		var pr *prepareResult
 
 		if pr, err = c.prepare(ctx, query); err == nil {
@@ -454,10 +659,10 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, e
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.setLastError(ctx, errCancelled)
 		return nil, ctx.Err()
 	case <-done:
-		c.lastError = err
+		c.setLastError(ctx, err)
 		return stmt, err
 	}
 }
@@ -466,9 +671,13 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, e
 func (c *conn) Close() error {
 	c.wg.Wait()                                        // wait until concurrent db calls are finalized
 	c.metrics.msgCh <- gaugeMsg{idx: gaugeConn, v: -1} // decrement open connections.
-	// do not disconnect if isBad or invalid sessionID
-	if !c.isBad() && c.sessionID != defaultSessionID {
+	if c.attrs._meter != nil {
+		c.attrs._meter.Add(context.Background(), MeterConnections, -1)
+	}
+	// do not disconnect if isBad, invalid sessionID or in use (e.g. by a lob read)
+	if !c.isBad() && c.sessionID != defaultSessionID && c.lock() == nil {
 		c.disconnect(context.Background()) //nolint:errcheck
+		c.unlock()
 	}
 	err := c.dbConn.close()
 	stdConnTracker.remove()
@@ -481,6 +690,9 @@ func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 		return nil, ErrNestedTransaction
 	}
 
+	c.txStartBytes = c.dbConn.bytes()
+	c.txEndBytes = c.txStartBytes
+
 	var isolationLevelQuery string
 	switch sql.IsolationLevel(opts.Isolation) {
 	case sql.LevelDefault, sql.LevelReadCommitted:
@@ -493,12 +705,17 @@ func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 		return nil, ErrUnsupportedIsolationLevel
 	}
 
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+
 	done := make(chan struct{})
 	var tx driver.Tx
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
+		defer c.unlock()
 		// set isolation level
 		if _, err = c.execDirect(ctx, isolationLevelQuery, !c.inTx); err != nil {
 			goto done
@@ -520,10 +737,10 @@ func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.setLastError(ctx, errCancelled)
 		return nil, ctx.Err()
 	case <-done:
-		c.lastError = err
+		c.setLastError(ctx, err)
 		return tx, err
 	}
 }
@@ -532,33 +749,57 @@ var callStmt = regexp.MustCompile(`(?i)^\s*call\s+.*`) // sql statement beginnin
 
 // QueryContext implements the driver.QueryerContext interface.
 func (c *conn) QueryContext(ctx context.Context, query string, nvargs []driver.NamedValue) (driver.Rows, error) {
-	if callStmt.MatchString(query) {
-		return nil, fmt.Errorf("invalid procedure call %s - please use Exec instead", query)
-	}
-	if len(nvargs) != 0 {
-		return nil, driver.ErrSkip // fast path not possible (prepare needed)
+	if len(nvargs) != 0 || callStmt.MatchString(query) {
+		return nil, driver.ErrSkip // fast path not possible (prepare needed - procedure calls are queried via stmt)
 	}
 	if c.sqlTrace {
 		defer c.logSQLTrace(ctx, time.Now(), query, nvargs)
 	}
 
+	return c.runQuery(ctx, selectStmt.MatchString(query), func() (driver.Rows, error) { return c.queryDirect(ctx, query, !c.inTx) })
+}
+
+/*
+runQuery executes the query function fn holding the connection lock. readOnly reports whether the query
+does read data only, so that it can be retried safely in case of a connection error (see retryError).
+
+Queries executed with a context which can never be done (e.g. context.Background) are run on the calling
+goroutine, as there is no cancellation to wait for. This saves the goroutine and synchronization overhead
+per query. Queries with a cancelable context are run on a separate goroutine, so that the call returns as
+soon as the context is done.
+*/
+func (c *conn) runQuery(ctx context.Context, readOnly bool, fn func() (driver.Rows, error)) (driver.Rows, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+
+	numMessage := c.pw.NumMessage()
+
+	if ctx.Done() == nil {
+		defer c.unlock()
+		rows, err := fn()
+		c.setLastError(ctx, err)
+		return rows, c.retryError(err, c.pw.NumMessage() != numMessage, readOnly)
+	}
+
 	done := make(chan struct{})
 	var rows driver.Rows
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
-		rows, err = c.queryDirect(ctx, query, !c.inTx)
+		defer c.unlock()
+		rows, err = fn()
 		close(done)
 	}()
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.cancelled(done)
 		return nil, ctx.Err()
 	case <-done:
-		c.lastError = err
-		return rows, err
+		c.setLastError(ctx, err)
+		return rows, c.retryError(err, c.pw.NumMessage() != numMessage, readOnly)
 	}
 }
 
@@ -571,12 +812,19 @@ func (c *conn) ExecContext(ctx context.Context, query string, nvargs []driver.Na
 		defer c.logSQLTrace(ctx, time.Now(), query, nvargs)
 	}
 
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+
+	numMessage := c.pw.NumMessage()
+
 	done := make(chan struct{})
 	var result driver.Result
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
+		defer c.unlock()
 		// handle procesure call without parameters here as well
 		result, err = c.execDirect(ctx, query, !c.inTx)
 		close(done)
@@ -584,21 +832,25 @@ func (c *conn) ExecContext(ctx context.Context, query string, nvargs []driver.Na
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.cancelled(done)
 		return nil, ctx.Err()
 	case <-done:
-		c.lastError = err
-		return result, err
+		c.setLastError(ctx, err)
+		return result, c.retryError(err, c.pw.NumMessage() != numMessage, false)
 	}
 }
 
 // CheckNamedValue implements the NamedValueChecker interface.
 func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
 	// - called by sql driver for ExecContext and QueryContext
-	// - no check needs to be performed as ExecContext and QueryContext provided
+	// - no conversion needs to be performed as ExecContext and QueryContext provided
 	//   with parameters will force the 'prepare way' (driver.ErrSkip)
 	// - Anyway, CheckNamedValue must be implemented to avoid default sql driver checks
 	//   which would fail for custom arg types like Lob
+	// - table arguments are rejected before the statement gets prepared
+	if isTableArg(nv.Value) {
+		return fmt.Errorf("%w: argument %d of type %T", ErrTableBindNotSupported, nv.Ordinal, nv.Value)
+	}
 	return nil
 }
 
@@ -612,26 +864,118 @@ func (c *conn) DatabaseName() string { return c.serverOptions.DatabaseNameOrZero
 
 // DBConnectInfo implements the Conn interface.
 func (c *conn) DBConnectInfo(ctx context.Context, databaseName string) (*DBConnectInfo, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+
 	done := make(chan struct{})
 	var ci *DBConnectInfo
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
+		defer c.unlock()
 		ci, err = c.dbConnectInfo(ctx, databaseName)
 		close(done)
 	}()
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.setLastError(ctx, errCancelled)
 		return nil, ctx.Err()
 	case <-done:
-		c.lastError = err
+		c.setLastError(ctx, err)
 		return ci, err
 	}
 }
 
+/*
+TxBytes implements the Conn interface.
+It returns the number of bytes transferred within the current transaction or, in case no transaction
+is active, within the last transaction of the connection. The counts are reset at the start of
+each transaction.
+*/
+func (c *conn) TxBytes() TxBytes {
+	end := c.txEndBytes
+	if c.inTx {
+		end = c.dbConn.bytes()
+	}
+	return TxBytes{Read: end.Read - c.txStartBytes.Read, Written: end.Written - c.txStartBytes.Written}
+}
+
+/*
+Stats implements the Conn interface.
+It returns a snapshot of the connection statistics since the connection was opened. As the counters
+are updated atomically, Stats is safe to be called concurrently to database calls on the connection.
+*/
+func (c *conn) Stats() ConnStats {
+	txBytes := c.dbConn.bytes()
+	return ConnStats{
+		ReadBytes:       txBytes.Read,
+		WrittenBytes:    txBytes.Written,
+		RoundTrips:      c.pw.NumMessage(),
+		Prepares:        c.stats.numPrepare.Load(),
+		OpenStatements:  c.stats.numOpenStmt.Load(),
+		LobReadBytes:    c.stats.lobBytesRead.Load(),
+		LobWrittenBytes: c.stats.lobBytesWritten.Load(),
+	}
+}
+
+/*
+LastReplyParts implements the Conn interface.
+It returns the kinds and the number of arguments of the parts of the last database server reply
+for debugging purposes. The parts are only recorded if enabled via Connector.SetDebugReplyParts,
+otherwise nil is returned.
+Please note that the last reply is the reply of the last database server round-trip, which might be
+a fetch of further result set rows or a LOB read and not the execution of the statement itself.
+*/
+func (c *conn) LastReplyParts() []ReplyPart {
+	parts := c.pr.LastReplyParts()
+	if parts == nil {
+		return nil
+	}
+	replyParts := make([]ReplyPart, len(parts))
+	for i, part := range parts {
+		replyParts[i] = ReplyPart{Kind: part.Kind.String(), NumArg: part.NumArg}
+	}
+	return replyParts
+}
+
+// ResetTransaction implements the Conn interface.
+// It rolls back the current transaction and resets the client side transaction state,
+// so that a connection can be used again after a transaction got aborted on server side.
+func (c *conn) ResetTransaction(ctx context.Context) error {
+	if c.isBad() {
+		return driver.ErrBadConn
+	}
+
+	if err := c.lock(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	var err error
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.unlock()
+		if err = c.rollback(ctx); err == nil {
+			c.inTx = false
+			c.txEndBytes = c.dbConn.bytes()
+		}
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		c.setLastError(ctx, errCancelled)
+		return ctx.Err()
+	case <-done:
+		c.setLastError(ctx, err)
+		return err
+	}
+}
+
 func (c *conn) logSQLTrace(ctx context.Context, start time.Time, query string, nvargs []driver.NamedValue) {
 	const maxArg = 5 // limit the number of arguments to 5
 	l := len(nvargs)
@@ -660,7 +1004,11 @@ func (c *conn) addTimeValue(start time.Time, k int) {
 }
 
 func (c *conn) addSQLTimeValue(start time.Time, k int) {
-	c.metrics.msgCh <- sqlTimeMsg{idx: k, d: time.Since(start)}
+	d := time.Since(start)
+	c.metrics.msgCh <- sqlTimeMsg{idx: k, d: d}
+	if c.attrs._meter != nil {
+		c.meterSQLTime(d, k)
+	}
 }
 
 // transaction.
@@ -691,12 +1039,18 @@ func (t *tx) close(rollback bool) error {
 	if c.isBad() {
 		return driver.ErrBadConn
 	}
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
 	if t.closed {
 		return nil
 	}
 	t.closed = true
 
 	c.inTx = false
+	defer func() { c.txEndBytes = c.dbConn.bytes() }()
 
 	if rollback {
 		return c.rollback(context.Background())
@@ -734,8 +1088,8 @@ func (c *conn) authenticate(ctx context.Context, authHnd *p.AuthHnd, attrs *conn
 
 	// client context
 	clientContext := &p.ClientContext{}
-	clientContext.SetVersion(DriverVersion)
-	clientContext.SetType(clientType)
+	clientContext.SetVersion(attrs._clientVersion)
+	clientContext.SetType(attrs._clientProduct)
 	clientContext.SetApplicationProgram(attrs._applicationName)
 
 	initRequest, err := authHnd.InitRequest()
@@ -776,6 +1130,9 @@ func (c *conn) authenticate(ctx context.Context, authHnd *p.AuthHnd, attrs *conn
 	if attrs._locale != "" {
 		co.SetClientLocale(attrs._locale)
 	}
+	if attrs._resultFormat == ColumnarResultFormat {
+		co.SetColumnarResultSet(true)
+	}
 
 	if err := c.pw.Write(ctx, c.sessionID, p.MtConnect, false, finalRequest, p.ClientID(clientID), co); err != nil {
 		return 0, nil, err
@@ -805,15 +1162,40 @@ func (c *conn) authenticate(ctx context.Context, authHnd *p.AuthHnd, attrs *conn
 	return c.pr.SessionID(), co, nil
 }
 
+// command returns the statement text sent to the database server for query or the error of the statement filter.
+func (c *conn) command(ctx context.Context, query string) (p.Command, error) {
+	query = hintQuery(ctx, query)
+	if c.attrs._statementTagger != nil {
+		query = tagQuery(c.attrs._statementTagger(ctx), query)
+	}
+	if c.attrs._statementFilter != nil { // filter statement as sent (after rewriting)
+		if err := c.attrs._statementFilter(query); err != nil {
+			return nil, fmt.Errorf("statement rejected by filter: %w", err)
+		}
+	}
+	return p.Command(query), nil
+}
+
 func (c *conn) queryDirect(ctx context.Context, query string, commit bool) (driver.Rows, error) {
+	if c.attrs._tracer != nil {
+		return traced(ctx, c, SpanQuery, query, nil, func(ctx context.Context) (driver.Rows, error) { return c._queryDirect(ctx, query, commit) })
+	}
+	return c._queryDirect(ctx, query, commit)
+}
+
+func (c *conn) _queryDirect(ctx context.Context, query string, commit bool) (driver.Rows, error) {
 	defer c.addSQLTimeValue(time.Now(), sqlTimeQuery)
 
 	// allow e.g inserts as query -> handle commit like in _execDirect
-	if err := c.pw.Write(ctx, c.sessionID, p.MtExecuteDirect, commit, p.Command(query)); err != nil {
+	command, err := c.command(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pw.Write(ctx, c.sessionID, p.MtExecuteDirect, commit, command); err != nil {
 		return nil, err
 	}
 
-	qr := &queryResult{conn: c}
+	qr := &queryResult{conn: c, ctx: ctx, lobEncoding: lobEncoding(ctx)}
 	meta := &p.ResultMetadata{}
 	resSet := &p.Resultset{}
 
@@ -830,47 +1212,88 @@ func (c *conn) queryDirect(ctx context.Context, query string, commit bool) (driv
 			qr.fieldValues = resSet.FieldValues
 			qr.decodeErrors = resSet.DecodeErrors
 			qr.attrs = attrs
+			c.meterRowsFetched(ctx, qr)
 		}
 	}); err != nil {
 		return nil, err
 	}
-	if qr.rsID == 0 { // non select query
+	if qr.rsID == 0 { // non select query (e.g. insert, upsert, delete): changes are applied and sql.Row.Scan returns sql.ErrNoRows
 		return noResult, nil
 	}
 	return qr, nil
 }
 
 func (c *conn) execDirect(ctx context.Context, query string, commit bool) (driver.Result, error) {
+	if c.attrs._tracer != nil {
+		return traced(ctx, c, SpanExec, query, nil, func(ctx context.Context) (driver.Result, error) { return c._execDirect(ctx, query, commit) })
+	}
+	return c._execDirect(ctx, query, commit)
+}
+
+func (c *conn) _execDirect(ctx context.Context, query string, commit bool) (driver.Result, error) {
 	defer c.addSQLTimeValue(time.Now(), sqlTimeExec)
 
-	if err := c.pw.Write(ctx, c.sessionID, p.MtExecuteDirect, commit, p.Command(query)); err != nil {
+	command, err := c.command(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pw.Write(ctx, c.sessionID, p.MtExecuteDirect, commit, command); err != nil {
 		return nil, err
 	}
 
 	rows := &p.RowsAffected{}
 	var numRow int64
+	var rsID uint64
+	var rsClosed bool
 	if err := c.pr.IterateParts(ctx, func(kind p.PartKind, attrs p.PartAttributes, read func(part p.Part)) {
-		if kind == p.PkRowsAffected {
+		switch kind {
+		case p.PkRowsAffected: // sum up in case the reply contains more than one rows affected part
 			read(rows)
-			numRow = rows.Total()
+			numRow += rows.Total()
+		case p.PkResultsetID:
+			read((*p.ResultsetID)(&rsID))
+		case p.PkResultset: // not read (e.g. admin statements returning a result set)
+			rsClosed = attrs.ResultsetClosed()
 		}
 	}); err != nil {
 		return nil, err
 	}
-	if c.pr.FunctionCode() == p.FcDDL {
+	fc := c.pr.FunctionCode()
+	// close result sets left open by statements returning a result set on exec (e.g. some ALTER SYSTEM statements).
+	if rsID != 0 && !rsClosed {
+		if err := c.closeResultsetID(ctx, rsID); err != nil {
+			return nil, err
+		}
+	}
+	if fc == p.FcDDL {
 		return driver.ResultNoRows, nil
 	}
 	return driver.RowsAffected(numRow), nil
 }
 
 func (c *conn) prepare(ctx context.Context, query string) (*prepareResult, error) {
+	if c.attrs._tracer != nil {
+		return traced(ctx, c, SpanPrepare, query, nil, func(ctx context.Context) (*prepareResult, error) { return c._prepare(ctx, query) })
+	}
+	return c._prepare(ctx, query)
+}
+
+func (c *conn) _prepare(ctx context.Context, query string) (*prepareResult, error) {
 	defer c.addSQLTimeValue(time.Now(), sqlTimePrepare)
 
-	if err := c.pw.Write(ctx, c.sessionID, p.MtPrepare, false, p.Command(query)); err != nil {
+	if err := checkNumPlaceholder(query); err != nil {
 		return nil, err
 	}
 
-	pr := &prepareResult{}
+	command, err := c.command(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pw.Write(ctx, c.sessionID, p.MtPrepare, false, command); err != nil {
+		return nil, err
+	}
+
+	pr := &prepareResult{query: query}
 	resMeta := &p.ResultMetadata{}
 	prmMeta := &p.ParameterMetadata{}
 
@@ -889,15 +1312,23 @@ func (c *conn) prepare(ctx context.Context, query string) (*prepareResult, error
 		return nil, err
 	}
 	pr.fc = c.pr.FunctionCode()
+	c.stats.numPrepare.Add(1)
 	return pr, nil
 }
 
 func (c *conn) query(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue, commit bool) (driver.Rows, error) {
+	if c.attrs._tracer != nil {
+		return traced(ctx, c, SpanQuery, pr.query, pr, func(ctx context.Context) (driver.Rows, error) { return c._query(ctx, pr, nvargs, commit) })
+	}
+	return c._query(ctx, pr, nvargs, commit)
+}
+
+func (c *conn) _query(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue, commit bool) (driver.Rows, error) {
 	defer c.addSQLTimeValue(time.Now(), sqlTimeQuery)
 
 	// allow e.g inserts as query -> handle commit like in exec
 
-	if err := convertQueryArgs(pr.parameterFields, nvargs, c.attrs._cesu8Encoder(), c.attrs._lobChunkSize); err != nil {
+	if err := convertQueryArgs(pr.parameterFields, nvargs, c.attrs._cesu8Encoder(), lobChunkSize(ctx, c.attrs._lobChunkSize), c.attrs.convertOptions()); err != nil {
 		return nil, err
 	}
 	inputParameters, err := p.NewInputParameters(pr.parameterFields, nvargs)
@@ -907,8 +1338,9 @@ func (c *conn) query(ctx context.Context, pr *prepareResult, nvargs []driver.Nam
 	if err := c.pw.Write(ctx, c.sessionID, p.MtExecute, commit, p.StatementID(pr.stmtID), inputParameters); err != nil {
 		return nil, err
 	}
+	c.stats.lobBytesWritten.Add(uint64(inputParameters.LobBytes()))
 
-	qr := &queryResult{conn: c, fields: pr.resultFields}
+	qr := &queryResult{conn: c, fields: pr.resultFields, ctx: ctx, lobEncoding: lobEncoding(ctx)}
 	resSet := &p.Resultset{}
 
 	if err := c.pr.IterateParts(ctx, func(kind p.PartKind, attrs p.PartAttributes, read func(part p.Part)) {
@@ -921,11 +1353,12 @@ func (c *conn) query(ctx context.Context, pr *prepareResult, nvargs []driver.Nam
 			qr.fieldValues = resSet.FieldValues
 			qr.decodeErrors = resSet.DecodeErrors
 			qr.attrs = attrs
+			c.meterRowsFetched(ctx, qr)
 		}
 	}); err != nil {
 		return nil, err
 	}
-	if qr.rsID == 0 { // non select query
+	if qr.rsID == 0 { // non select query (e.g. insert, upsert, delete): changes are applied and sql.Row.Scan returns sql.ErrNoRows
 		return noResult, nil
 	}
 	return qr, nil
@@ -939,18 +1372,24 @@ func (c *conn) exec(ctx context.Context, pr *prepareResult, nvargs []driver.Name
 	if err := c.pw.Write(ctx, c.sessionID, p.MtExecute, commit, p.StatementID(pr.stmtID), inputParameters); err != nil {
 		return nil, err
 	}
+	c.stats.lobBytesWritten.Add(uint64(inputParameters.LobBytes()))
 
+	/*
+		DML statements do not return a result set, so the reply does only consist of the rows affected
+		and, in case of LOB input parameters, of the write LOB reply part. No result set related parts
+		are set up and the write LOB reply is only allocated if sent by the database server.
+	*/
 	rows := &p.RowsAffected{Ofs: ofs}
+	var rowCounts []int64
 	var ids []p.LocatorID
-	lobReply := &p.WriteLobReply{}
-	var rowsAffected int64
 
 	if err := c.pr.IterateParts(ctx, func(kind p.PartKind, attrs p.PartAttributes, read func(part p.Part)) {
 		switch kind {
-		case p.PkRowsAffected:
+		case p.PkRowsAffected: // collect in case the reply contains more than one rows affected part
 			read(rows)
-			rowsAffected = rows.Total()
+			rowCounts = append(rowCounts, rows.RowCounts()...)
 		case p.PkWriteLobReply:
+			lobReply := &p.WriteLobReply{}
 			read(lobReply)
 			ids = lobReply.IDs
 		}
@@ -970,7 +1409,7 @@ func (c *conn) exec(ctx context.Context, pr *prepareResult, nvargs []driver.Name
 			write lob data only for the last record as lob streaming is only available for the last one
 		*/
 		startLastRec := len(nvargs) - len(pr.parameterFields)
-		if err := c.encodeLobs(nil, ids, pr.parameterFields, nvargs[startLastRec:]); err != nil {
+		if err := c.encodeLobs(ctx, nil, ids, pr.parameterFields, nvargs[startLastRec:]); err != nil {
 			return nil, err
 		}
 	}
@@ -978,11 +1417,11 @@ func (c *conn) exec(ctx context.Context, pr *prepareResult, nvargs []driver.Name
 	if fc == p.FcDDL {
 		return driver.ResultNoRows, nil
 	}
-	return driver.RowsAffected(rowsAffected), nil
+	return &BatchResult{rowCounts: rowCounts}, nil
 }
 
 func (c *conn) execCall(ctx context.Context, outputFields []*p.ParameterField) (*callResult, []p.LocatorID, int64, error) {
-	cr := &callResult{conn: c, outputFields: outputFields}
+	cr := &callResult{conn: c, outputFields: outputFields, ctx: ctx, lobEncoding: lobEncoding(ctx)}
 
 	var qr *queryResult
 	rows := &p.RowsAffected{}
@@ -1012,7 +1451,7 @@ func (c *conn) execCall(ctx context.Context, outputFields []*p.ParameterField) (
 				- resultset might not be provided for all tables
 				- so, 'additional' query result is detected by new metadata part
 			*/
-			qr = &queryResult{conn: c}
+			qr = &queryResult{conn: c, ctx: ctx, lobEncoding: cr.lobEncoding}
 			cr.outputFields = append(cr.outputFields, p.NewTableRowsParameterField(tableRowIdx))
 			cr.fieldValues = append(cr.fieldValues, qr)
 			tableRowIdx++
@@ -1024,6 +1463,7 @@ func (c *conn) execCall(ctx context.Context, outputFields []*p.ParameterField) (
 			qr.fieldValues = resSet.FieldValues
 			qr.decodeErrors = resSet.DecodeErrors
 			qr.attrs = attrs
+			c.meterRowsFetched(ctx, qr)
 		case p.PkResultsetID:
 			read((*p.ResultsetID)(&qr.rsID))
 		case p.PkWriteLobReply:
@@ -1039,18 +1479,20 @@ func (c *conn) execCall(ctx context.Context, outputFields []*p.ParameterField) (
 func (c *conn) fetchNext(ctx context.Context, qr *queryResult) error {
 	defer c.addSQLTimeValue(time.Now(), sqlTimeFetch)
 
-	if err := c.pw.Write(ctx, c.sessionID, p.MtFetchNext, false, p.ResultsetID(qr.rsID), p.Fetchsize(c.attrs._fetchSize)); err != nil {
+	if err := c.pw.Write(ctx, c.sessionID, p.MtFetchNext, false, p.ResultsetID(qr.rsID), p.Fetchsize(fetchSize(qr.ctx, c.attrs._fetchSize))); err != nil {
 		return err
 	}
 
-	resSet := &p.Resultset{ResultFields: qr.fields, FieldValues: qr.fieldValues} // reuse field values
+	resSet := &p.Resultset{ResultFields: qr.fields, FieldValues: qr.fieldValues, ValueBuffer: qr.valueBuf} // reuse field values
 
 	return c.pr.IterateParts(ctx, func(kind p.PartKind, attrs p.PartAttributes, read func(part p.Part)) {
 		if kind == p.PkResultset {
 			read(resSet)
 			qr.fieldValues = resSet.FieldValues
+			qr.valueBuf = resSet.ValueBuffer
 			qr.decodeErrors = resSet.DecodeErrors
 			qr.attrs = attrs
+			c.meterRowsFetched(ctx, qr)
 		}
 	})
 }
@@ -1109,6 +1551,37 @@ func (c *conn) disconnect(ctx context.Context) error {
 	return nil
 }
 
+var errMaxOpenLobs = errors.New("maximum number of open lobs exceeded")
+
+/*
+openLob sets the lob decoder of descr (with clob encoding enc) and registers the lob locator in case the
+number of open lobs is limited (see MaxOpenLobs) and the lob content is not provided completely by the result.
+The lob content is read in the context ctx of the statement providing the lob, so that reading the lob
+stops as soon as the context is done.
+openLob returns true if the lob locator got registered, so that the caller can unregister it on close.
+An error is returned if the number of open lobs would exceed the MaxOpenLobs limit.
+*/
+func (c *conn) openLob(ctx context.Context, descr *p.LobOutDescr, enc textencoding.Encoding) (bool, error) {
+	descr.SetDecoder(func(descr *p.LobOutDescr, wr io.Writer) error { return c.decodeLob(ctx, descr, wr, enc) })
+	if c.attrs._maxOpenLobs <= 0 || descr.Opt.IsLastData() { // no limit or nothing left to read: no need to register
+		return false, nil
+	}
+	if _, ok := c.openLobs[descr.ID]; ok {
+		return false, nil
+	}
+	if len(c.openLobs) >= c.attrs._maxOpenLobs {
+		return false, fmt.Errorf("%w: limit %d - please read lobs before fetching further rows", errMaxOpenLobs, c.attrs._maxOpenLobs)
+	}
+	if c.openLobs == nil {
+		c.openLobs = map[p.LocatorID]struct{}{}
+	}
+	c.openLobs[descr.ID] = struct{}{}
+	return true, nil
+}
+
+// closeLob unregisters the lob locator id.
+func (c *conn) closeLob(id p.LocatorID) { delete(c.openLobs, id) }
+
 // decodeLobs decodes (reads from db) output lob or result lob parameters.
 
 /*
@@ -1116,14 +1589,21 @@ read lob reply
   - seems like readLobreply returns only a result for one lob - even if more then one is requested
     --> read single lobs
 */
-func (c *conn) decodeLob(descr *p.LobOutDescr, wr io.Writer) error {
+func (c *conn) decodeLob(ctx context.Context, descr *p.LobOutDescr, wr io.Writer, enc textencoding.Encoding) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
 	defer c.addSQLTimeValue(time.Now(), sqlTimeFetchLob)
+	defer c.closeLob(descr.ID)
 
 	var err error
 
-	if descr.IsCharBased {
+	switch {
+	case descr.IsCharBased:
 		wrcl := transform.NewWriter(wr, c.attrs._cesu8Decoder()) // CESU8 transformer
-		err = c._decodeLob(descr, wrcl, func(b []byte) (size int, numChar int) {
+		err = c._decodeLob(ctx, descr, wrcl, func(b []byte) (size int, numChar int) {
 			for len(b) > 0 {
 				if !cesu8.FullRune(b) {
 					return
@@ -1139,8 +1619,13 @@ func (c *conn) decodeLob(descr *p.LobOutDescr, wr io.Writer) error {
 			}
 			return
 		})
-	} else {
-		err = c._decodeLob(descr, wr, func(b []byte) (int, int) { return len(b), len(b) })
+	case descr.IsClob && enc != nil: // explicit clob encoding (see WithLobEncoding)
+		wrcl := transform.NewWriter(wr, enc.NewDecoder())
+		if err = c._decodeLob(ctx, descr, wrcl, func(b []byte) (int, int) { return len(b), len(b) }); err == nil {
+			err = wrcl.Close() // flush
+		}
+	default:
+		err = c._decodeLob(ctx, descr, wr, func(b []byte) (int, int) { return len(b), len(b) })
 	}
 
 	if pw, ok := wr.(*io.PipeWriter); ok { // if the writer is a pipe-end -> close at the end
@@ -1153,7 +1638,7 @@ func (c *conn) decodeLob(descr *p.LobOutDescr, wr io.Writer) error {
 	return err
 }
 
-func (c *conn) _decodeLob(descr *p.LobOutDescr, wr io.Writer, countChars func(b []byte) (int, int)) error {
+func (c *conn) _decodeLob(ctx context.Context, descr *p.LobOutDescr, wr io.Writer, countChars func(b []byte) (int, int)) error {
 	lobChunkSize := int64(c.attrs._lobChunkSize)
 
 	chunkSize := func(numChar, ofs int64) int32 {
@@ -1168,17 +1653,22 @@ func (c *conn) _decodeLob(descr *p.LobOutDescr, wr io.Writer, countChars func(b
 	if _, err := wr.Write(descr.B[:size]); err != nil {
 		return err
 	}
+	c.stats.lobBytesRead.Add(uint64(size))
 
 	lobRequest := &p.ReadLobRequest{}
 	lobRequest.ID = descr.ID
 
 	lobReply := &p.ReadLobReply{}
 
-	eof := descr.Opt.IsLastData()
-
-	ctx := context.Background()
+	eof := descr.Opt.IsLastData() || descr.NumChar == 0 // empty (zero-length) lob
 
 	for !eof {
+		// stop fetching lob chunks if the statement context is done
+		// (the connection stays usable as no request is pending).
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		lobRequest.Ofs += int64(numChar)
 		lobRequest.ChunkSize = chunkSize(descr.NumChar, lobRequest.Ofs)
 
@@ -1202,6 +1692,7 @@ func (c *conn) _decodeLob(descr *p.LobOutDescr, wr io.Writer, countChars func(b
 		if _, err := wr.Write(lobReply.B[:size]); err != nil {
 			return err
 		}
+		c.stats.lobBytesRead.Add(uint64(size))
 		eof = lobReply.Opt.IsLastData()
 	}
 	return nil
@@ -1213,10 +1704,38 @@ func assertEqual[T comparable](s string, a, b T) {
 	}
 }
 
+/*
+LobWriteError is returned in case streaming lob data to the database server fails, e.g. because
+the lob reader returns an error or the context got cancelled. Written reports the number of lob bytes
+confirmed by the database server before the error occurred. As the database server is left in the middle
+of a lob write operation the connection is reported as bad after a cancellation.
+*/
+type LobWriteError struct {
+	Written int64
+	err     error
+}
+
+func (e *LobWriteError) Error() string {
+	return fmt.Sprintf("lob write failed after %d bytes written: %s", e.Written, e.err)
+}
+
+// Unwrap returns the underlying error.
+func (e *LobWriteError) Unwrap() error { return e.err }
+
+// lobWriteError returns a LobWriteError with the context error in case ctx is cancelled, or with err otherwise.
+func lobWriteError(ctx context.Context, written int64, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = ctxErr
+	}
+	return &LobWriteError{Written: written, err: err}
+}
+
 // encodeLobs encodes (write to db) input lob parameters.
-func (c *conn) encodeLobs(cr *callResult, ids []p.LocatorID, inPrmFields []*p.ParameterField, nvargs []driver.NamedValue) error {
+func (c *conn) encodeLobs(ctx context.Context, cr *callResult, ids []p.LocatorID, inPrmFields []*p.ParameterField, nvargs []driver.NamedValue) error {
 	assertEqual("lob streaming can only be done for one (the last) record", len(inPrmFields), len(nvargs))
 
+	var written int64 // lob bytes confirmed by the database server
+
 	descrs := make([]*p.WriteLobDescr, 0, len(ids))
 	j := 0
 	for i, f := range inPrmFields {
@@ -1228,6 +1747,7 @@ func (c *conn) encodeLobs(cr *callResult, ids []p.LocatorID, inPrmFields []*p.Pa
 			if j > len(ids) {
 				return fmt.Errorf("protocol error: invalid number of lob parameter ids %d", len(ids))
 			}
+			written += int64(lobInDescr.ChunkSize()) // first chunk is sent with the statement
 			if !lobInDescr.Opt.IsLastData() {
 				descrs = append(descrs, &p.WriteLobDescr{LobInDescr: lobInDescr, ID: ids[j]})
 				j++
@@ -1235,11 +1755,23 @@ func (c *conn) encodeLobs(cr *callResult, ids []p.LocatorID, inPrmFields []*p.Pa
 		}
 	}
 
-	writeLobRequest := &p.WriteLobRequest{}
+	if len(descrs) == 0 {
+		return nil
+	}
+
+	// bind the lob streaming to ctx to abort a chunk being written on cancellation
+	// (if not bound already by a bulk exec).
+	if c.dbConn.ctx == nil {
+		c.dbConn.startHandshake(ctx)
+		defer c.dbConn.endHandshake()
+	}
 
-	ctx := context.Background()
+	writeLobRequest := &p.WriteLobRequest{}
 
 	for len(descrs) != 0 {
+		if err := ctx.Err(); err != nil {
+			return &LobWriteError{Written: written, err: err}
+		}
 
 		if len(descrs) != len(ids) {
 			return fmt.Errorf("protocol error: invalid number of lob parameter ids %d - expected %d", len(descrs), len(ids))
@@ -1251,16 +1783,18 @@ func (c *conn) encodeLobs(cr *callResult, ids []p.LocatorID, inPrmFields []*p.Pa
 		}
 
 		// TODO check total size limit
+		var chunkSize int64
 		for _, descr := range descrs {
-			if err := descr.FetchNext(c.attrs._lobChunkSize); err != nil {
-				return err
+			if err := descr.FetchNext(lobChunkSize(ctx, c.attrs._lobChunkSize)); err != nil {
+				return &LobWriteError{Written: written, err: err}
 			}
+			chunkSize += int64(descr.LobInDescr.ChunkSize())
 		}
 
 		writeLobRequest.Descrs = descrs
 
 		if err := c.pw.Write(ctx, c.sessionID, p.MtReadLob, false, writeLobRequest); err != nil {
-			return err
+			return lobWriteError(ctx, written, err)
 		}
 
 		lobReply := &p.WriteLobReply{}
@@ -1278,8 +1812,10 @@ func (c *conn) encodeLobs(cr *callResult, ids []p.LocatorID, inPrmFields []*p.Pa
 				ids = lobReply.IDs
 			}
 		}); err != nil {
-			return err
+			return lobWriteError(ctx, written, err)
 		}
+		written += chunkSize
+		c.stats.lobBytesWritten.Add(uint64(chunkSize))
 
 		// remove done descr
 		j := 0
@@ -3,10 +3,17 @@
 package driver
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"io"
+	"sync"
 	"testing"
+	"time"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
 )
 
 func testCancelContext(t *testing.T, db *sql.DB) {
@@ -67,6 +74,412 @@ func testCheckCallStmt(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testResetTransaction(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	tx, err := sqlConn.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// induce error within transaction
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("insert into %s values (1)", RandomIdentifier("notExisting_"))); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if err := sqlConn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*conn)
+		if !ok {
+			t.Fatal("connection does not implement *conn")
+		}
+		if !c.inTx {
+			t.Fatal("connection not in transaction")
+		}
+		if err := c.ResetTransaction(ctx); err != nil {
+			return err
+		}
+		if c.inTx {
+			t.Fatal("connection still in transaction")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// connection should be usable again
+	if err := sqlConn.PingContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testLoadUnload(t *testing.T, db *sql.DB) {
+	tableName := RandomIdentifier("loadUnload_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", tableName), 42); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, query := range []string{
+		fmt.Sprintf("unload %s", tableName),
+		fmt.Sprintf("load %s all", tableName),
+		fmt.Sprintf("unload %s delete persistent memory", tableName),
+		fmt.Sprintf("load %s (i)", tableName),
+	} {
+		// minimal replies (no rows affected, warnings only) must not result in an error.
+		if _, err := db.Exec(query); err != nil {
+			t.Fatalf("%s: %s", query, err)
+		}
+	}
+
+	var i int
+	if err := db.QueryRow(fmt.Sprintf("select i from %s", tableName)).Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+	if i != 42 {
+		t.Fatalf("got %d - expected %d", i, 42)
+	}
+}
+
+func testTxBytes(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	txBytes := func() (txBytes TxBytes) {
+		if err := sqlConn.Raw(func(driverConn any) error {
+			txBytes = driverConn.(Conn).TxBytes()
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	if txBytes := txBytes(); txBytes != (TxBytes{}) {
+		t.Fatalf("got %v - expected zero bytes before first transaction", txBytes)
+	}
+
+	tx, err := sqlConn.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := tx.QueryContext(ctx, "select * from sys.tables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+
+	inTx := txBytes()
+	if inTx.Read == 0 || inTx.Written == 0 {
+		t.Fatalf("got %v - expected transferred bytes", inTx)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	// transaction end: commit is included
+	committed := txBytes()
+	if committed.Read <= inTx.Read || committed.Written <= inTx.Written {
+		t.Fatalf("got %v - expected more than %v", committed, inTx)
+	}
+	// no transaction active: counts are kept
+	if err := sqlConn.PingContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if txBytes := txBytes(); txBytes != committed {
+		t.Fatalf("got %v - expected %v", txBytes, committed)
+	}
+
+	// new transaction: counts are reset
+	tx, err = sqlConn.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+	if txBytes := txBytes(); txBytes.Read >= inTx.Read || txBytes.Written >= inTx.Written {
+		t.Fatalf("got %v - expected less than %v", txBytes, inTx)
+	}
+}
+
+func testConnStats(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	stats := func() (stats ConnStats) {
+		if err := sqlConn.Raw(func(driverConn any) error {
+			stats = driverConn.(Conn).Stats()
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	table := RandomIdentifier("connStats_")
+	if _, err := sqlConn.ExecContext(ctx, fmt.Sprintf("create table %s (b blob)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	start := stats()
+
+	const lobSize = 1000
+	lob := make([]byte, lobSize)
+	stmt, err := sqlConn.PrepareContext(ctx, fmt.Sprintf("insert into %s values (?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats := stats(); stats.Prepares != start.Prepares+1 || stats.OpenStatements != start.OpenStatements+1 {
+		t.Fatalf("got %v - expected one more prepare and open statement than %v", stats, start)
+	}
+	if _, err := stmt.ExecContext(ctx, lob); err != nil {
+		t.Fatal(err)
+	}
+	stmt.Close()
+
+	var b Lob
+	b.SetWriter(new(bytes.Buffer))
+	if err := sqlConn.QueryRowContext(ctx, fmt.Sprintf("select b from %s", table)).Scan(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	end := stats()
+	if end.ReadBytes <= start.ReadBytes || end.WrittenBytes <= start.WrittenBytes {
+		t.Fatalf("got %v - expected more transferred bytes than %v", end, start)
+	}
+	if end.RoundTrips <= start.RoundTrips+2 { // at least prepare, exec and query
+		t.Fatalf("got %d round-trips - expected more than %d", end.RoundTrips, start.RoundTrips+2)
+	}
+	if end.OpenStatements != start.OpenStatements {
+		t.Fatalf("got %d open statements - expected %d", end.OpenStatements, start.OpenStatements)
+	}
+	if end.LobWrittenBytes-start.LobWrittenBytes != lobSize || end.LobReadBytes-start.LobReadBytes != lobSize {
+		t.Fatalf("got lob bytes written %d read %d - expected %d", end.LobWrittenBytes-start.LobWrittenBytes, end.LobReadBytes-start.LobReadBytes, lobSize)
+	}
+}
+
+func testLastReplyParts(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	// reply parts are not recorded by default
+	defaultConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer defaultConn.Close()
+	if err := rawConn(defaultConn, func(c *conn) error {
+		if parts := c.LastReplyParts(); parts != nil {
+			t.Fatalf("got reply parts %v - expected nil", parts)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	connector := MT.NewConnector()
+	connector.SetDebugReplyParts(true)
+	debugDB := sql.OpenDB(connector)
+	defer debugDB.Close()
+
+	sqlConn, err := debugDB.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	hasPart := func(kind string) bool {
+		var parts []ReplyPart
+		if err := sqlConn.Raw(func(driverConn any) error {
+			parts = driverConn.(Conn).LastReplyParts()
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		for _, part := range parts {
+			if part.Kind == kind {
+				return true
+			}
+		}
+		t.Logf("reply parts %v", parts)
+		return false
+	}
+
+	table := RandomIdentifier("replyParts_")
+	if _, err := sqlConn.ExecContext(ctx, fmt.Sprintf("create table %s (i integer)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		kind string
+		fct  func() error
+	}{
+		{"insert", "PkRowsAffected", func() error {
+			_, err := sqlConn.ExecContext(ctx, fmt.Sprintf("insert into %s values (1)", table))
+			return err
+		}},
+		{"prepare", "PkParameterMetadata", func() error {
+			stmt, err := sqlConn.PrepareContext(ctx, fmt.Sprintf("select * from %s where i = ?", table))
+			if err != nil {
+				return err
+			}
+			// do not close statement before checking parts as closing sends a further request
+			t.Cleanup(func() { stmt.Close() })
+			return nil
+		}},
+		{"error", "PkError", func() error {
+			if _, err := sqlConn.ExecContext(ctx, "select * from not_existing_table"); err == nil {
+				return errors.New("error expected")
+			}
+			return nil
+		}},
+	}
+
+	for _, test := range tests {
+		if err := test.fct(); err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if !hasPart(test.kind) {
+			t.Fatalf("%s: reply part %s expected", test.name, test.kind)
+		}
+	}
+}
+
+func testConcurrentUse(t *testing.T, db *sql.DB) {
+	const numGoroutine = 10
+
+	ctx := context.Background()
+
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	if err := rawConn(sqlConn, func(c *conn) error {
+		var wg sync.WaitGroup
+		errs := make([]error, numGoroutine)
+		for i := 0; i < numGoroutine; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = c.ExecContext(ctx, "set 'concurrent' = 'x'", nil)
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			// either the statement was executed or it was rejected - but the protocol must not get corrupted.
+			if err != nil && !errors.Is(err, ErrConcurrentUse) {
+				t.Fatal(err)
+			}
+		}
+		// connection is still usable
+		if _, err := c.ExecContext(ctx, "set 'concurrent' = 'y'", nil); err != nil {
+			t.Fatal(err)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentUse(t *testing.T) {
+	c := &conn{}
+	if err := c.lock(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	qr := &queryResult{conn: c}
+
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"exec", func() error { _, err := c.ExecContext(ctx, "select * from dummy", nil); return err }},
+		{"fetch", func() error { return qr.Next(nil) }},
+		{"closeResultset", qr.Close},
+		{"readLob", func() error { return c.decodeLob(ctx, &p.LobOutDescr{}, io.Discard, nil) }},
+	}
+
+	for _, test := range tests {
+		if err := test.fn(); !errors.Is(err, ErrConcurrentUse) {
+			t.Fatalf("%s: got error %v - expected %v", test.name, err, ErrConcurrentUse)
+		}
+	}
+
+	c.unlock()
+	if err := c.lock(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testServerCancel(t *testing.T, db *sql.DB) {
+	const sleepSeconds = 10
+
+	ctr := MT.NewConnector()
+	ctr.SetServerCancel(true)
+	cancelDB := sql.OpenDB(ctr)
+	defer cancelDB.Close()
+
+	sqlConn, err := cancelDB.Conn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	var connectionID int64
+	if err := rawConn(sqlConn, func(c *conn) error { connectionID = c.connectionID; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if connectionID <= 0 {
+		t.Fatalf("invalid connection id %d", connectionID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := sqlConn.ExecContext(ctx, fmt.Sprintf("do begin call sqlscript_sync:sleep_seconds(%d); end", sleepSeconds)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error %v - expected %v", err, context.DeadlineExceeded)
+	}
+
+	// the statement should be cancelled by the database server before it would complete
+	for {
+		var numActive int
+		if err := db.QueryRow("select count(*) from m_active_statements where connection_id = ? and statement_status = 'ACTIVE'", connectionID).Scan(&numActive); err != nil {
+			t.Fatal(err)
+		}
+		if numActive == 0 {
+			break
+		}
+		if time.Since(start) > sleepSeconds*time.Second/2 {
+			t.Fatalf("statement of connection %d not cancelled by the database server", connectionID)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
 func TestConnection(t *testing.T) {
 	t.Parallel()
 
@@ -76,6 +489,13 @@ func TestConnection(t *testing.T) {
 	}{
 		{"cancelContext", testCancelContext},
 		{"checkCallStmt", testCheckCallStmt},
+		{"resetTransaction", testResetTransaction},
+		{"loadUnload", testLoadUnload},
+		{"txBytes", testTxBytes},
+		{"connStats", testConnStats},
+		{"lastReplyParts", testLastReplyParts},
+		{"concurrentUse", testConcurrentUse},
+		{"serverCancel", testServerCancel},
 	}
 
 	db := MT.DB()
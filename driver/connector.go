@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql/driver"
 	"os"
 	"path"
@@ -28,6 +29,9 @@ type Connector struct {
 	*authAttrs
 
 	metrics *metrics
+
+	hostMu   sync.Mutex
+	lastHost string // host of the last established connection
 }
 
 // NewConnector returns a new Connector instance with default values.
@@ -73,6 +77,28 @@ func NewX509AuthConnectorByFiles(host, clientCertFile, clientKeyFile string) (*C
 	return NewX509AuthConnector(host, clientCert, clientKey)
 }
 
+// NewX509AuthConnectorByCertificate creates a connector for X509 (client certificate) authentication
+// based on a tls.Certificate (e.g. loaded via tls.LoadX509KeyPair).
+// To present the certificate in the TLS handshake as well please see SetTLSClientAuth.
+func NewX509AuthConnectorByCertificate(host string, cert tls.Certificate) (*Connector, error) {
+	c := NewConnector()
+	c._host = host
+	var err error
+	if c._certKey, err = auth.NewTLSCertKey(cert); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewSAMLAuthConnector creates a connector for SAML (assertion) based authentication.
+// The SAML assertion is requested from samlProvider whenever a session is established (see SetSAMLAssertionProvider).
+func NewSAMLAuthConnector(host string, samlProvider func(ctx context.Context) (assertion string, err error)) *Connector {
+	c := NewConnector()
+	c._host = host
+	c._samlProvider = samlProvider
+	return c
+}
+
 // NewJWTAuthConnector creates a connector for token (JWT) based authentication.
 func NewJWTAuthConnector(host, token string) *Connector {
 	c := NewConnector()
@@ -87,14 +113,30 @@ func newDSNConnector(dsn *DSN) (*Connector, error) {
 	c._databaseName = dsn.databaseName
 	c._pingInterval = dsn.pingInterval
 	c._defaultSchema = dsn.defaultSchema
+	c._serverCancel = dsn.serverCancel
 	c.setTimeout(dsn.timeout)
 	if dsn.tls != nil {
 		if err := c.connAttrs.setTLS(dsn.tls.ServerName, dsn.tls.InsecureSkipVerify, dsn.tls.RootCAFiles); err != nil {
 			return nil, err
 		}
+		if dsn.tls.ClientCertFile != "" {
+			clientCert, err := os.ReadFile(path.Clean(dsn.tls.ClientCertFile))
+			if err != nil {
+				return nil, err
+			}
+			clientKey, err := os.ReadFile(path.Clean(dsn.tls.ClientKeyFile))
+			if err != nil {
+				return nil, err
+			}
+			if c._certKey, err = auth.NewCertKey(clientCert, clientKey); err != nil {
+				return nil, err
+			}
+			c._tlsClientAuth = true
+		}
 	}
 	c._username = dsn.username
 	c._password = dsn.password
+	c._token = dsn.token
 	return c, nil
 }
 
@@ -116,11 +158,22 @@ func (c *Connector) Host() string { return c._host }
 // DatabaseName returns the tenant database name of the connector.
 func (c *Connector) DatabaseName() string { return c._databaseName }
 
-func (c *Connector) redirect(ctx context.Context) (driver.Conn, error) {
+// sessionConnAttrs returns a copy of the connection attributes used for a new connection.
+func (c *Connector) sessionConnAttrs() *connAttrs {
 	connAttrs := c.connAttrs.clone()
+	if connAttrs._tlsClientAuth && connAttrs._tlsConfig != nil {
+		// certificate is requested during the handshake to take certificate refreshes into account.
+		connAttrs._tlsConfig.GetClientCertificate = c.authAttrs.tlsClientCertificate
+	}
+	return connAttrs
+}
+
+func (c *Connector) redirect(ctx context.Context) (driver.Conn, error) {
+	connAttrs := c.sessionConnAttrs()
 
 	if redirectHost, found := redirectCache.Load(redirectCacheKey{host: c._host, databaseName: c._databaseName}); found {
 		if conn, err := connect(ctx, redirectHost.(string), c.metrics, connAttrs, c.authAttrs); err == nil {
+			c.connected(connAttrs, redirectHost.(string))
 			return conn, nil
 		}
 	}
@@ -135,16 +188,35 @@ func (c *Connector) redirect(ctx context.Context) (driver.Conn, error) {
 	}
 
 	redirectCache.Store(redirectCacheKey{host: c._host, databaseName: c._databaseName}, redirectHost)
+	c.connected(connAttrs, redirectHost)
 
 	return conn, err
 }
 
+// connected records host as host of the last established connection and calls the redirect hook in case host
+// differs from the host of the previous connection (initially the connector host), e.g. after a failover.
+func (c *Connector) connected(connAttrs *connAttrs, host string) {
+	c.hostMu.Lock()
+	from := c.lastHost
+	if from == "" {
+		from = c._host
+	}
+	c.lastHost = host
+	c.hostMu.Unlock()
+
+	if connAttrs._redirectHook != nil && host != from {
+		connAttrs._redirectHook(from, host)
+	}
+}
+
 // Connect implements the database/sql/driver/Connector interface.
 func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
-	if c._databaseName != "" {
-		return c.redirect(ctx)
-	}
-	return connect(ctx, c._host, c.metrics, c.connAttrs.clone(), c.authAttrs)
+	return reconnect(ctx, c.ReconnectPolicy(), func(ctx context.Context) (driver.Conn, error) {
+		if c._databaseName != "" {
+			return c.redirect(ctx)
+		}
+		return connect(ctx, c._host, c.metrics, c.sessionConnAttrs(), c.authAttrs)
+	})
 }
 
 // Driver implements the database/sql/driver/Connector interface.
@@ -3,9 +3,11 @@
 package driver
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 )
 
 func testExistSessionVariables(t *testing.T, sv1, sv2 map[string]string) {
@@ -124,6 +126,111 @@ func testRetryConnect(t *testing.T) {
 	}
 }
 
+func testClientProduct(t *testing.T) {
+	const name, version = "go-hdb-wrapper", "9.9.9"
+
+	connector := MT.NewConnector()
+	if name, version := connector.ClientProduct(); name != clientType || version != DriverVersion {
+		t.Fatalf("client product %s %s - expected %s %s", name, version, clientType, DriverVersion)
+	}
+	connector.SetClientProduct(name, version)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	for _, value := range []string{name, version} {
+		var key string
+		if err := db.QueryRow("select key from m_session_context where connection_id = current_connection and value = ?", value).Scan(&key); err != nil {
+			t.Fatalf("client product value %s not found in m_session_context: %s", value, err)
+		}
+		t.Logf("client product value %s: key %s", value, key)
+	}
+}
+
+func testApplicationUser(t *testing.T) {
+	const appName, appUser, tenantUser = "go-hdb-app", "app-user", "tenant-user"
+
+	ctx := context.Background()
+
+	connector := MT.NewConnector()
+	connector.SetApplicationName(appName)
+	connector.SetApplicationUser(appUser)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	checkSessionContext := func(key, value string) {
+		t.Helper()
+		var v string
+		if err := sqlConn.QueryRowContext(ctx, "select value from m_session_context where connection_id = current_connection and key = ?", key).Scan(&v); err != nil {
+			t.Fatalf("key %s not found in m_session_context: %s", key, err)
+		}
+		if v != value {
+			t.Fatalf("key %s: value %s - expected %s", key, v, value)
+		}
+	}
+
+	checkSessionContext(svApplication, appName)
+	checkSessionContext(svApplicationUser, appUser)
+
+	// update application user per request
+	if err := SetApplicationUser(ctx, sqlConn, tenantUser); err != nil {
+		t.Fatal(err)
+	}
+	checkSessionContext(svApplicationUser, tenantUser)
+
+	// reset session: application user of connector
+	if err := rawConn(sqlConn, func(c *conn) error { return c.ResetSession(ctx) }); err != nil {
+		t.Fatal(err)
+	}
+	checkSessionContext(svApplicationUser, appUser)
+}
+
+func testConnectorReuse(t *testing.T) {
+	ctx := context.Background()
+
+	// database/sql does close the connector if it implements io.Closer - the connector needs to stay usable
+	connector := MT.NewConnector()
+	for i := 0; i < 2; i++ {
+		db := sql.OpenDB(connector)
+		if err := db.PingContext(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// driver.DB owned metrics are closed
+	db := OpenDB(connector)
+	if err := db.PingContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// leak check: the metrics collector go routine needs to be stopped
+	stopped := make(chan struct{})
+	go func() {
+		db.metrics.wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("metrics collector go routine not stopped after close")
+	}
+	// metrics are flushed
+	if stats := db.metrics.stats(); stats.OpenConnections != 0 {
+		t.Fatalf("open connections %d - expected %d", stats.OpenConnections, 0)
+	}
+}
+
 func TestConnector(t *testing.T) {
 	t.Parallel()
 
@@ -133,6 +240,9 @@ func TestConnector(t *testing.T) {
 	}{
 		{"testSessionVariables", testSessionVariables},
 		{"testRetryConnect", testRetryConnect},
+		{"testClientProduct", testClientProduct},
+		{"testApplicationUser", testApplicationUser},
+		{"testConnectorReuse", testConnectorReuse},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,125 @@
+package driver
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+	"golang.org/x/text/encoding"
+)
+
+/*
+WithHoldCursor returns a context which keeps result sets of queries executed with this context open on commit (WITH HOLD).
+By default result sets are closed by the database server on commit.
+
+Please note that held result sets do consume database server resources (e.g. memory and consistent views)
+until all rows are fetched or the result set is closed. So please close held rows as soon as they are not needed anymore.
+*/
+func WithHoldCursor(ctx context.Context) context.Context { return p.WithHoldCursor(ctx) }
+
+type statementMemoryLimitCtxKey struct{}
+
+/*
+WithStatementMemoryLimit returns a context which limits the memory a statement executed or prepared with this
+context may consume on the database server to limit bytes (STATEMENT_MEMORY_LIMIT hint).
+If the limit is exceeded the statement is aborted by the database server - see IsStatementMemoryLimit.
+
+Please note that
+  - for prepared statements the context of the prepare call is relevant
+  - the hint is appended to select statements only, statements containing a hint clause already are left unchanged
+*/
+func WithStatementMemoryLimit(ctx context.Context, limit int64) context.Context {
+	return context.WithValue(ctx, statementMemoryLimitCtxKey{}, limit)
+}
+
+var (
+	selectStmt = regexp.MustCompile(`(?i)^[\s(]*select\s`)    // sql statement beginning with select
+	hintClause = regexp.MustCompile(`(?i)\swith\s+hint\s*\(`) // sql statement containing a hint clause
+)
+
+// hintQuery returns query with the hints requested by ctx attached.
+func hintQuery(ctx context.Context, query string) string {
+	limit, ok := ctx.Value(statementMemoryLimitCtxKey{}).(int64)
+	if !ok || !selectStmt.MatchString(query) || hintClause.MatchString(query) {
+		return query
+	}
+	// hint on a new line: a trailing line comment of query must not comment out the hint.
+	return strings.TrimRight(query, " \t\r\n;") + "\nwith hint(statement_memory_limit(" + strconv.FormatInt(limit, 10) + "))"
+}
+
+// tagQuery returns query prefixed by tag as comment.
+func tagQuery(tag, query string) string {
+	if tag == "" {
+		return query
+	}
+	return "/* " + strings.ReplaceAll(tag, "*/", "* /") + " */ " + query
+}
+
+type lobEncodingCtxKey struct{}
+
+/*
+WithLobEncoding returns a context which decodes the content of CLOB values of queries and procedure calls
+executed with this context using encoding enc (e.g. charmap.ISO8859_1 for Latin-1 encoded legacy data).
+The decoded content is provided in UTF-8.
+
+By default the CLOB content is provided as delivered by the database server. Please note that
+  - the encoding does apply to CLOB but not to NCLOB values, which are always provided in UTF-8
+  - for prepared statements the context of the query or exec call is relevant
+*/
+func WithLobEncoding(ctx context.Context, enc encoding.Encoding) context.Context {
+	return context.WithValue(ctx, lobEncodingCtxKey{}, enc)
+}
+
+// lobEncoding returns the lob encoding requested by ctx or nil.
+func lobEncoding(ctx context.Context) encoding.Encoding {
+	enc, _ := ctx.Value(lobEncodingCtxKey{}).(encoding.Encoding)
+	return enc
+}
+
+type lobChunkSizeCtxKey struct{}
+
+/*
+WithLobChunkSize returns a context which overrides the lobChunkSize of the connector (see Connector.SetLobChunkSize)
+for lob parameters written by statements executed with this context, e.g. to use small chunks for metadata lobs and
+large chunks for huge binary lobs on the same connection.
+The size is limited to the range supported by the database protocol. Please note that
+  - for prepared statements the context of the query or exec call is relevant
+  - a size <= 0 falls back to the lobChunkSize of the connector
+*/
+func WithLobChunkSize(ctx context.Context, size int) context.Context {
+	return context.WithValue(ctx, lobChunkSizeCtxKey{}, size)
+}
+
+// lobChunkSize returns the lob chunk size requested by ctx or defaultSize.
+func lobChunkSize(ctx context.Context, defaultSize int) int {
+	size, ok := ctx.Value(lobChunkSizeCtxKey{}).(int)
+	if !ok || size <= 0 {
+		return defaultSize
+	}
+	return min(max(size, minLobChunkSize), maxLobChunkSize)
+}
+
+type fetchSizeCtxKey struct{}
+
+/*
+WithFetchSize returns a context which overrides the fetchSize of the connector (see Connector.SetFetchSize)
+for queries executed with this context, so that the database server returns up to size rows per fetch request.
+Large fetch sizes reduce the number of database round-trips of large scans at the cost of client memory.
+The size is limited to the range supported by the database protocol. Please note that
+  - for prepared statements the context of the query call is relevant
+  - a size <= 0 falls back to the fetchSize of the connector
+*/
+func WithFetchSize(ctx context.Context, size int) context.Context {
+	return context.WithValue(ctx, fetchSizeCtxKey{}, size)
+}
+
+// fetchSize returns the fetch size requested by ctx or defaultSize.
+func fetchSize(ctx context.Context, defaultSize int) int {
+	size, ok := ctx.Value(fetchSizeCtxKey{}).(int)
+	if !ok || size <= 0 {
+		return defaultSize
+	}
+	return min(size, maxFetchSize)
+}
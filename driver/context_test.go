@@ -0,0 +1,222 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func TestHoldCursor(t *testing.T) {
+	t.Parallel()
+
+	const numRow = 100
+
+	connector := MT.NewConnector()
+	connector.SetFetchSize(10) // force multiple fetches
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	tableName := RandomIdentifier("holdCursor_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	args := make([]any, numRow)
+	for i := 0; i < numRow; i++ {
+		args[i] = i
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", tableName), args...); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	rows, err := sqlConn.QueryContext(WithHoldCursor(ctx), fmt.Sprintf("select i from %s order by i", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	i := 0
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		if v != i {
+			t.Fatalf("value %d - expected %d", v, i)
+		}
+		if i == 0 { // commit after first row - rows must remain readable
+			if _, err := sqlConn.ExecContext(ctx, "commit"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		i++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != numRow {
+		t.Fatalf("number of rows %d - expected %d", i, numRow)
+	}
+}
+
+func TestStatementMemoryLimit(t *testing.T) {
+	t.Parallel()
+
+	const query = "select a.table_name, b.table_name from sys.tables a cross join sys.tables b order by 1, 2"
+
+	db := MT.DB()
+
+	// no limit
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+
+	// tiny limit
+	ctx := WithStatementMemoryLimit(context.Background(), 1024)
+	rows, err = db.QueryContext(ctx, query)
+	if err == nil {
+		for rows.Next() {
+		}
+		err = rows.Err()
+		rows.Close()
+	}
+	if !IsStatementMemoryLimit(err) {
+		t.Fatalf("got error %v - expected statement memory limit error", err)
+	}
+}
+
+func TestHintQuery(t *testing.T) {
+	const hint = "\nwith hint(statement_memory_limit(1024))"
+
+	ctx := WithStatementMemoryLimit(context.Background(), 1024)
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"select * from dummy", "select * from dummy" + hint},
+		{" SELECT * from dummy;\n", " SELECT * from dummy" + hint},
+		{"(select 1 from dummy) union (select 2 from dummy)", "(select 1 from dummy) union (select 2 from dummy)" + hint},
+		{"select * from dummy -- comment", "select * from dummy -- comment" + hint},
+		{"select * from dummy -- comment\n", "select * from dummy -- comment" + hint},
+		{"select * from dummy with hint(no_cs_join)", "select * from dummy with hint(no_cs_join)"},
+		{"select * from dummy WITH HINT (no_cs_join)", "select * from dummy WITH HINT (no_cs_join)"},
+		{"insert into t values (1)", "insert into t values (1)"},
+		{"call p()", "call p()"},
+	}
+
+	for _, test := range tests {
+		if query := hintQuery(ctx, test.query); query != test.expected {
+			t.Fatalf("query %q - expected %q", query, test.expected)
+		}
+	}
+	if query := hintQuery(context.Background(), "select * from dummy"); query != "select * from dummy" {
+		t.Fatalf("query %q - expected %q", query, "select * from dummy")
+	}
+}
+
+func testFetchSizeQuery(ctx context.Context, tb testing.TB, sqlConn *sql.Conn, numRow int) uint64 {
+	stats := func() (stats ConnStats) {
+		if err := sqlConn.Raw(func(driverConn any) error {
+			stats = driverConn.(Conn).Stats()
+			return nil
+		}); err != nil {
+			tb.Fatal(err)
+		}
+		return
+	}
+
+	start := stats()
+	rows, err := sqlConn.QueryContext(ctx, fmt.Sprintf("select generated_period_start from series_generate_integer(1, 0, %d)", numRow))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	rows.Close()
+	if n != numRow {
+		tb.Fatalf("number of rows %d - expected %d", n, numRow)
+	}
+	return stats().RoundTrips - start.RoundTrips
+}
+
+func TestFetchSize(t *testing.T) {
+	t.Parallel()
+
+	// fallback and limits
+	ctx := context.Background()
+	for _, test := range []struct{ size, expected int }{
+		{0, defaultFetchSize},
+		{-1, defaultFetchSize},
+		{1, 1},
+		{1000, 1000},
+	} {
+		if size := fetchSize(WithFetchSize(ctx, test.size), defaultFetchSize); size != test.expected {
+			t.Fatalf("size %d: got %d - expected %d", test.size, size, test.expected)
+		}
+	}
+	if size := fetchSize(ctx, defaultFetchSize); size != defaultFetchSize {
+		t.Fatalf("got %d - expected %d", size, defaultFetchSize)
+	}
+
+	const numRow = 1000
+
+	connector := MT.NewConnector()
+	connector.SetFetchSize(10)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	defRoundTrips := testFetchSizeQuery(ctx, t, sqlConn, numRow)
+	roundTrips := testFetchSizeQuery(WithFetchSize(ctx, numRow), t, sqlConn, numRow)
+	if roundTrips >= defRoundTrips {
+		t.Fatalf("round-trips %d - expected less than %d (connector fetch size)", roundTrips, defRoundTrips)
+	}
+}
+
+func BenchmarkFetchSize(b *testing.B) {
+	const numRow = 1000000
+
+	ctx := context.Background()
+	db := MT.DB()
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer sqlConn.Close()
+
+	for _, size := range []int{0, 1000, 10000, 100000} {
+		name := "default"
+		if size != 0 {
+			name = fmt.Sprintf("%d", size)
+		}
+		b.Run(name, func(b *testing.B) {
+			var roundTrips uint64
+			for i := 0; i < b.N; i++ {
+				roundTrips += testFetchSizeQuery(WithFetchSize(ctx, size), b, sqlConn, numRow)
+			}
+			b.ReportMetric(float64(roundTrips)/float64(b.N), "roundtrips/op")
+		})
+	}
+}
@@ -3,11 +3,15 @@ package driver
 import (
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"time"
 
 	p "github.com/SAP/go-hdb/driver/internal/protocol"
 	"github.com/SAP/go-hdb/driver/internal/protocol/levenshtein"
+	hdbreflect "github.com/SAP/go-hdb/driver/internal/reflect"
 	"golang.org/x/text/transform"
 )
 
@@ -25,34 +29,113 @@ func isNilArg(v any) bool {
 	return isNilArg(rv.Elem().Interface())
 }
 
-// TODO: test.
+/*
+reorderNVArgs moves the first argument at or after pos named name to pos, keeping the order of all other arguments.
+In case several parameters share the same name, the named arguments are assigned in the order of their occurrence,
+meaning the n-th argument named name is bound to the n-th parameter named name.
+*/
 func reorderNVArgs(pos int, name string, nvargs []driver.NamedValue) {
+	if name == "" {
+		return
+	}
 	for i := pos; i < len(nvargs); i++ {
-		if nvargs[i].Name != "" && nvargs[i].Name == name {
-			tmp := nvargs[i]
-			for j := i; j > pos; j-- {
-				nvargs[j] = nvargs[j-1]
-			}
-			nvargs[pos] = tmp
+		if nvargs[i].Name == name {
+			nvarg := nvargs[i]
+			copy(nvargs[pos+1:i+1], nvargs[pos:i])
+			nvargs[pos] = nvarg
+			return
 		}
 	}
 }
 
-func convertArg(field *p.ParameterField, arg driver.Value, cesu8Encoder transform.Transformer) (any, error) {
+// valuerArg returns the value of arg in case arg implements the driver.Valuer interface
+// or an encoder is registered for the type of arg (see RegisterEncoder).
+func valuerArg(arg driver.Value) (driver.Value, error) {
 	// let fields with own value converter convert themselves first (e.g. NullInt64, ...)
 	// .check nested Value converters as well (e.g. sql.Null[T] has driver.Decimal as value)
 	for !isNilArg(arg) {
-		valuer, ok := arg.(driver.Valuer)
+		var err error
+		if valuer, ok := arg.(driver.Valuer); ok {
+			if arg, err = valuer.Value(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		fn, v, ok := encoder(arg)
 		if !ok {
 			break
 		}
-		var err error
-		if arg, err = valuer.Value(); err != nil {
+		if arg, err = fn(v); err != nil {
 			return nil, err
 		}
 	}
+	return arg, nil
+}
+
+// binaryUUIDArg returns the binary representation of arg in case arg is an UUID, an UUID pointer
+// or a nullable UUID (null values are returned as nil). Any other arg is returned unchanged.
+func binaryUUIDArg(arg driver.Value) driver.Value {
+	switch arg := arg.(type) {
+	case UUID:
+		return arg[:]
+	case *UUID:
+		if arg == nil {
+			return nil
+		}
+		return arg[:]
+	default:
+		v, _ := nullUUIDArg(arg)
+		return v
+	}
+}
+
+func convertArg(field *p.ParameterField, arg driver.Value, cesu8Encoder transform.Transformer, opts p.ConvertOptions) (any, error) {
+	if field.IsBinary() { // bind binary representation of UUIDs
+		arg = binaryUUIDArg(arg)
+	}
+	if _, ok := arg.(p.ConvertedValue); !ok { // already converted by ParameterConverter
+		var err error
+		if arg, err = valuerArg(arg); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name(), err)
+		}
+	}
 	// convert field
-	return field.Convert(arg, cesu8Encoder)
+	return field.Convert(arg, cesu8Encoder, opts)
+}
+
+// convertColumn converts the values of column (slice) for field. If supported by field the conversion
+// is resolved once for the whole column, otherwise each value is converted separately.
+func convertColumn(field *p.ParameterField, column any, cesu8Encoder transform.Transformer, opts p.ConvertOptions) ([]any, error) {
+	if column, ok := column.(convertedColumn); ok { // already converted by ParameterConverter
+		values := make([]any, len(column))
+		for i, v := range column {
+			var err error
+			if _, ok := v.(p.ConvertedValue); ok {
+				values[i], err = field.Convert(v, cesu8Encoder, opts)
+			} else { // nil and lob values
+				values[i], err = convertArg(field, v, cesu8Encoder, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", i, err)
+			}
+		}
+		return values, nil
+	}
+	if values, ok, err := field.ConvertColumn(column, opts); ok || err != nil {
+		return values, err
+	}
+	rv := reflect.ValueOf(column)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("field %s: invalid column type %T - slice expected", field.Name(), column)
+	}
+	values := make([]any, rv.Len())
+	for i := range values {
+		var err error
+		if values[i], err = convertArg(field, rv.Index(i).Interface(), cesu8Encoder, opts); err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+	return values, nil
 }
 
 /*
@@ -61,40 +144,50 @@ convertExecArgs
   - out parameters are not supported
   - named parameters are not supported
 */
-func convertExecArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, cesu8Encoder transform.Transformer, lobChunkSize int) ([]int, error) {
+func convertExecArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, cesu8Encoder transform.Transformer, lobChunkSize int, opts p.ConvertOptions) ([]int, error) {
 	numField := len(fields)
 	if (len(nvargs) % numField) != 0 {
 		return nil, fmt.Errorf("invalid number of arguments %d - multiple of %d expected", len(nvargs), numField)
 	}
+	for i := range nvargs {
+		field := fields[i%numField]
+		nvarg := &nvargs[i]
+
+		if field.Out() {
+			return nil, fmt.Errorf("invalid parameter %s - output not allowed", field)
+		}
+		if _, ok := nvarg.Value.(sql.Out); ok {
+			return nil, fmt.Errorf("invalid argument %v - output not allowed", nvarg)
+		}
+		if nvarg.Name != "" {
+			return nil, fmt.Errorf("invalid argument %s - named parameters not supported", nvarg.Name)
+		}
+		var err error
+		if nvarg.Value, err = convertArg(field, nvarg.Value, cesu8Encoder, opts); err != nil {
+			return nil, err
+		}
+	}
+	return fetchFirstLobChunks(numField, nvargs, lobChunkSize)
+}
+
+// fetchFirstLobChunks fetches the first chunk of all lob arguments of the (converted) rows in nvargs
+// and returns the indexes of the rows with additional lob data to be written.
+func fetchFirstLobChunks(numField int, nvargs []driver.NamedValue, lobChunkSize int) ([]int, error) {
 	numRow := len(nvargs) / numField
 	addLobDataRecs := []int{}
 
 	for i := 0; i < numRow; i++ {
 		hasAddLobData := false
-		for j, field := range fields {
-			nvarg := &nvargs[(i*numField)+j]
-
-			if field.Out() {
-				return nil, fmt.Errorf("invalid parameter %s - output not allowed", field)
-			}
-			if _, ok := nvarg.Value.(sql.Out); ok {
-				return nil, fmt.Errorf("invalid argument %v - output not allowed", nvarg)
+		for j := 0; j < numField; j++ {
+			lobInDescr, ok := nvargs[(i*numField)+j].Value.(*p.LobInDescr)
+			if !ok {
+				continue
 			}
-			if nvarg.Name != "" {
-				return nil, fmt.Errorf("invalid argument %s - named parameters not supported", nvarg.Name)
-			}
-			var err error
-			if nvarg.Value, err = convertArg(field, nvarg.Value, cesu8Encoder); err != nil {
-				return nil, fmt.Errorf("field %s conversion error - %w", field, err)
+			if err := lobInDescr.FetchNext(lobChunkSize); err != nil {
+				return nil, err
 			}
-			// fetch first lob chunk
-			if lobInDescr, ok := nvarg.Value.(*p.LobInDescr); ok {
-				if err := lobInDescr.FetchNext(lobChunkSize); err != nil {
-					return nil, err
-				}
-				if !lobInDescr.Opt.IsLastData() {
-					hasAddLobData = true
-				}
+			if !lobInDescr.Opt.IsLastData() {
+				hasAddLobData = true
 			}
 		}
 		if hasAddLobData || i == numRow-1 {
@@ -110,7 +203,7 @@ _convertQueryArgs
   - out parameters are not supported
   - named parameters are not supported
 */
-func convertQueryArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, cesu8Encoder transform.Transformer, lobChunkSize int) error {
+func convertQueryArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, cesu8Encoder transform.Transformer, lobChunkSize int, opts p.ConvertOptions) error {
 	if len(nvargs) != len(fields) {
 		return fmt.Errorf("invalid number of arguments %d - %d expected", len(nvargs), len(fields))
 	}
@@ -126,9 +219,12 @@ func convertQueryArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, ce
 		if nvarg.Name != "" {
 			return fmt.Errorf("invalid argument %s - named parameters not supported", nvarg.Name)
 		}
+		if isTableArg(nvarg.Value) {
+			return fmt.Errorf("%w: argument %d of type %T for parameter %s", ErrTableBindNotSupported, nvarg.Ordinal, nvarg.Value, field)
+		}
 		var err error
-		if nvarg.Value, err = convertArg(field, nvarg.Value, cesu8Encoder); err != nil {
-			return fmt.Errorf("field %s conversion error - %w", field, err)
+		if nvarg.Value, err = convertArg(field, nvarg.Value, cesu8Encoder, opts); err != nil {
+			return err
 		}
 		// fetch first lob chunk
 		if lobInDescr, ok := nvarg.Value.(*p.LobInDescr); ok {
@@ -140,6 +236,29 @@ func convertQueryArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, ce
 	return nil
 }
 
+/*
+ErrTableBindNotSupported is returned in case a slice of structs is bound to a query or procedure input parameter
+(e.g. select * from table(?)), as the driver does not encode table-typed parameters. Please provide the rows
+via a (temporary) table (see CreateTableArg) or use array binding instead.
+*/
+var ErrTableBindNotSupported = errors.New("table parameter binding is not supported")
+
+// isTableArg returns true in case arg is a slice of structs (table rows).
+func isTableArg(arg any) bool {
+	if _, ok := arg.(driver.Valuer); ok || arg == nil {
+		return false
+	}
+	rt := reflect.TypeOf(arg)
+	if rt.Kind() != reflect.Slice {
+		return false
+	}
+	rt = rt.Elem()
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt.Kind() == reflect.Struct && rt != timeReflectType
+}
+
 // convertCallArgs
 // - fields could be input or output fields
 // - number of args needs to be equal to number of fields
@@ -159,7 +278,7 @@ func newCallArgs() *callArgs {
 	}
 }
 
-func convertCallArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, cesu8Encoder transform.Transformer, lobChunkSize int) (*callArgs, error) {
+func convertCallArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, cesu8Encoder transform.Transformer, lobChunkSize int, opts p.ConvertOptions) (*callArgs, error) {
 	callArgs := newCallArgs()
 
 	if len(nvargs) < len(fields) { // number of fields needs to match number of args or be greater (add table output args)
@@ -184,16 +303,19 @@ func convertCallArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, ces
 
 		var err error
 		if field.In() {
+			if isTableArg(nvarg.Value) {
+				return nil, fmt.Errorf("%w: argument %d of type %T for parameter %s", ErrTableBindNotSupported, nvarg.Ordinal, nvarg.Value, field)
+			}
 			if isOut {
 				if !out.In {
 					return nil, fmt.Errorf("argument field %s mismatch - use in argument with out field", field)
 				}
-				if out.Dest, err = convertArg(field, out.Dest, cesu8Encoder); err != nil {
-					return nil, fmt.Errorf("field %s conversion error - %w", field, err)
+				if out.Dest, err = convertArg(field, out.Dest, cesu8Encoder, opts); err != nil {
+					return nil, err
 				}
 			} else {
-				if nvarg.Value, err = convertArg(field, nvarg.Value, cesu8Encoder); err != nil {
-					return nil, fmt.Errorf("field %s conversion error - %w", field, err)
+				if nvarg.Value, err = convertArg(field, nvarg.Value, cesu8Encoder, opts); err != nil {
+					return nil, err
 				}
 			}
 			// fetch first lob chunk
@@ -232,3 +354,194 @@ func convertCallArgs(fields []*p.ParameterField, nvargs []driver.NamedValue, ces
 	}
 	return callArgs, nil
 }
+
+/*
+Array binding of procedure calls:
+  - scalar input parameters can be bound to a slice of values
+  - the procedure is called for each element of the slices (rows) within one database round-trip
+  - arguments not bound to a slice are used for all calls
+  - array binding is not supported for procedures with output (inout, out or table output) parameters,
+    as the database server does not return output parameters for multiple rows, and for procedures with
+    lob parameters (ErrArrayBindNotSupported)
+  - array binding is not supported for procedures with real vector parameters, as slices of float values
+    are bound as vector values
+*/
+
+// ErrArrayBindNotSupported is returned in case array binding is used for a procedure which does not support array binding.
+var ErrArrayBindNotSupported = errors.New("array binding is not supported")
+
+var timeArrayReflectType = hdbreflect.TypeFor[[]time.Time]()
+
+// isArrayType returns true if t is a slice of scalar values (excluding []byte, which is bound as binary value).
+func isArrayType(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+	if t == timeArrayReflectType {
+		return true
+	}
+	switch t.Elem().Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// arrayArg returns the slice value of an array bound argument v and true, or false if v is not array bound.
+func arrayArg(v any) (reflect.Value, bool) {
+	if out, ok := v.(sql.Out); ok {
+		rv := reflect.ValueOf(out.Dest)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() || !isArrayType(rv.Type().Elem()) {
+			return reflect.Value{}, false
+		}
+		return rv.Elem(), true
+	}
+	if v == nil || !isArrayType(reflect.TypeOf(v)) {
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(v), true
+}
+
+// convertArrayCallArgs returns the number of rows of array bound call arguments and true, or false if no argument is array bound.
+func convertArrayCallArgs(fields []*p.ParameterField, nvargs []driver.NamedValue) (int, bool, error) {
+	// slices of float values are bound as vector values to real vector parameters.
+	if slices.ContainsFunc(fields, (*p.ParameterField).IsRealVector) {
+		return 0, false, nil
+	}
+
+	numRow := -1
+	for _, nvarg := range nvargs {
+		rv, ok := arrayArg(nvarg.Value)
+		if !ok {
+			continue
+		}
+		if _, ok := nvarg.Value.(sql.Out); ok {
+			return 0, false, fmt.Errorf("%w: output argument %d", ErrArrayBindNotSupported, nvarg.Ordinal)
+		}
+		switch {
+		case numRow == -1:
+			numRow = rv.Len()
+		case numRow != rv.Len():
+			return 0, false, fmt.Errorf("invalid array binding - array length %d - expected %d", rv.Len(), numRow)
+		}
+	}
+	if numRow == -1 {
+		return 0, false, nil
+	}
+	if len(nvargs) != len(fields) {
+		return 0, false, fmt.Errorf("%w: procedure with table output parameters", ErrArrayBindNotSupported)
+	}
+	for _, field := range fields {
+		if field.Out() {
+			return 0, false, fmt.Errorf("%w: output parameter %s", ErrArrayBindNotSupported, field)
+		}
+		if field.IsLob() {
+			return 0, false, fmt.Errorf("%w: lob parameter %s", ErrArrayBindNotSupported, field)
+		}
+	}
+	return numRow, true, nil
+}
+
+// arrayCallArgsRow returns the call arguments of row idx of array bound call arguments.
+func arrayCallArgsRow(nvargs []driver.NamedValue, idx int) []driver.NamedValue {
+	row := slices.Clone(nvargs)
+	for i, nvarg := range row {
+		rv, ok := arrayArg(nvarg.Value)
+		if ok {
+			row[i].Value = rv.Index(idx).Interface()
+		}
+	}
+	return row
+}
+
+var (
+	timeReflectType = hdbreflect.TypeFor[time.Time]()
+	outReflectType  = hdbreflect.TypeFor[sql.Out]()
+)
+
+// structArg returns the struct value of arg in case arg is a struct or a pointer to a struct
+// which is not a driver value (e.g. time.Time) or a driver.Valuer.
+func structArg(arg any) (reflect.Value, bool) {
+	if _, ok := arg.(driver.Valuer); ok || isNilArg(arg) {
+		return reflect.Value{}, false
+	}
+	rv := reflect.ValueOf(arg)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || rv.Type() == timeReflectType || rv.Type() == outReflectType {
+		return reflect.Value{}, false
+	}
+	return rv, true
+}
+
+/*
+structCallArgs returns the exported fields of a struct as named call arguments in the order of the procedure
+parameters if exactly one unnamed struct argument is provided for a procedure with more than one parameter:
+  - the parameter name of a field is the name of the sql field tag or the field name
+  - fields tagged with the 'out' option (e.g. `sql:"RESULT,,out"`) are bound as output parameters
+    and the struct argument needs to be a pointer to the struct
+  - fields tagged with "-" are ignored
+  - table output parameters are not supported
+
+In all other cases nvargs is returned unchanged.
+*/
+func structCallArgs(fields []*p.ParameterField, nvargs []driver.NamedValue) ([]driver.NamedValue, error) {
+	if len(fields) < 2 || len(nvargs) != 1 || nvargs[0].Name != "" {
+		return nvargs, nil
+	}
+	rv, ok := structArg(nvargs[0].Value)
+	if !ok {
+		return nvargs, nil
+	}
+
+	rt := rv.Type()
+	tagger, hasTagger := reflect.New(rt).Interface().(Tagger)
+
+	args := make([]driver.NamedValue, len(fields))
+	for _, structField := range reflect.VisibleFields(rt) {
+		if !structField.IsExported() || (structField.Anonymous && structField.Type.Kind() == reflect.Struct) {
+			continue
+		}
+		fieldTag := structField.Tag
+		if hasTagger {
+			if tag, ok := tagger.Tag(structField.Name); ok {
+				fieldTag = reflect.StructTag(tag)
+			}
+		}
+		column, ok := newStructColumn(structField.Name, structField.Type, structField.Index, fieldTag)
+		if !ok {
+			continue
+		}
+		name := column.Name()
+		i := slices.IndexFunc(fields, func(field *p.ParameterField) bool { return field.Name() == name })
+		if i == -1 {
+			return nil, fmt.Errorf("invalid parameter name %s of struct field %s - did you mean %s?",
+				name,
+				structField.Name,
+				levenshtein.MinString(fields, func(field *p.ParameterField) string { return field.Name() }, name, false),
+			)
+		}
+		if args[i].Ordinal != 0 {
+			return nil, fmt.Errorf("duplicate parameter name %s of struct field %s", name, structField.Name)
+		}
+		fv := rv.FieldByIndex(column.fieldIndex)
+		args[i] = driver.NamedValue{Name: name, Ordinal: i + 1}
+		if column.sqlOptions.Contains("out") {
+			if !fv.CanAddr() {
+				return nil, fmt.Errorf("struct field %s: output parameter %s requires a pointer to struct %s", structField.Name, name, rt)
+			}
+			args[i].Value = sql.Out{Dest: fv.Addr().Interface(), In: fields[i].In()}
+		} else {
+			args[i].Value = fv.Interface()
+		}
+	}
+	for i, arg := range args {
+		if arg.Ordinal == 0 {
+			return nil, fmt.Errorf("missing struct field for parameter %s", fields[i].Name())
+		}
+	}
+	return args, nil
+}
@@ -0,0 +1,120 @@
+package driver
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestReorderNVArgs(t *testing.T) {
+	named := func(names ...string) []driver.NamedValue {
+		nvargs := make([]driver.NamedValue, len(names))
+		for i, name := range names {
+			nvargs[i] = driver.NamedValue{Name: name, Ordinal: i + 1}
+		}
+		return nvargs
+	}
+	ordinals := func(nvargs []driver.NamedValue) []int {
+		r := make([]int, len(nvargs))
+		for i, nvarg := range nvargs {
+			r[i] = nvarg.Ordinal
+		}
+		return r
+	}
+
+	tests := []struct {
+		name     string
+		fields   []string
+		nvargs   []driver.NamedValue
+		ordinals []int
+	}{
+		{"alreadyOrdered", []string{"A", "B", "C"}, named("A", "B", "C"), []int{1, 2, 3}},
+		{"reverseOrdered", []string{"A", "B", "C"}, named("C", "B", "A"), []int{3, 2, 1}},
+		{"mixed", []string{"A", "B", "C"}, named("B", "C", "A"), []int{3, 1, 2}},
+		{"positional", []string{"A", "B", "C"}, named("", "", ""), []int{1, 2, 3}},
+		{"positionalAndNamed", []string{"A", "B", "C"}, named("", "C", "B"), []int{1, 3, 2}},
+		// duplicate parameter names are bound in the order of the arguments
+		{"duplicates", []string{"A", "A"}, named("A", "A"), []int{1, 2}},
+		{"duplicatesReverseOrdered", []string{"A", "B", "A"}, named("A", "A", "B"), []int{1, 3, 2}},
+		{"duplicatesInterleaved", []string{"B", "A", "B", "A"}, named("A", "B", "A", "B"), []int{2, 1, 4, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i, name := range test.fields {
+				reorderNVArgs(i, name, test.nvargs)
+			}
+			if ordinals := ordinals(test.nvargs); !slices.Equal(ordinals, test.ordinals) {
+				t.Fatalf("ordinals %v - expected %v", ordinals, test.ordinals)
+			}
+		})
+	}
+}
+
+// testScanOnly implements the sql.Scanner interface only.
+type testScanOnly struct{ s string }
+
+func (t *testScanOnly) Scan(src any) error { t.s = fmt.Sprint(src); return nil }
+
+// testValuer implements the driver.Valuer interface.
+type testValuer struct{ s string }
+
+func (t testValuer) Value() (driver.Value, error) { return "valuer " + t.s, nil }
+
+func TestRegisterEncoder(t *testing.T) {
+	RegisterEncoder(func(v testScanOnly) (driver.Value, error) { return "encoder " + v.s, nil })
+	RegisterEncoder(func(v testValuer) (driver.Value, error) { return "encoder " + v.s, nil })
+
+	tests := []struct {
+		arg driver.Value
+		v   driver.Value
+	}{
+		{testScanOnly{s: "a"}, "encoder a"},
+		{&testScanOnly{s: "b"}, "encoder b"},
+		{(*testScanOnly)(nil), (*testScanOnly)(nil)},
+		{testValuer{s: "c"}, "valuer c"}, // driver.Valuer takes precedence
+		{42, 42},
+	}
+
+	for _, test := range tests {
+		v, err := valuerArg(test.arg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != test.v {
+			t.Fatalf("arg %v: value %v - expected %v", test.arg, v, test.v)
+		}
+	}
+}
+
+func TestBinaryUUIDArg(t *testing.T) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	tests := []struct {
+		arg driver.Value
+		v   []byte
+	}{
+		{u, u[:]},
+		{&u, u[:]},
+		{(*UUID)(nil), nil},
+	}
+
+	for _, test := range tests {
+		v := binaryUUIDArg(test.arg)
+		if test.v == nil {
+			if v != nil {
+				t.Fatalf("arg %T: value %v - expected nil", test.arg, v)
+			}
+			continue
+		}
+		if b, ok := v.([]byte); !ok || !bytes.Equal(b, test.v) {
+			t.Fatalf("arg %T: value %v - expected %v", test.arg, v, test.v)
+		}
+	}
+
+	if v := binaryUUIDArg("abc"); v != "abc" {
+		t.Fatalf("value %v - expected %v", v, "abc")
+	}
+}
@@ -435,6 +435,13 @@ var doubleTestData = []any{
 var timeTestData = []any{
 	time.Now(),
 	time.Date(2000, 12, 31, 23, 59, 59, 999999999, time.UTC),
+	// edge dates
+	time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1500, 2, 28, 8, 15, 30, 0, time.UTC), // julian calendar
+	time.Date(1582, 10, 4, 0, 0, 0, 0, time.UTC),   // last day of julian calendar
+	time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC),  // first day of gregorian calendar
+	time.Date(1600, 2, 29, 0, 0, 0, 0, time.UTC),
+	time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
 	sql.NullTime{Valid: false, Time: time.Now()},
 	sql.NullTime{Valid: true, Time: time.Now()},
 }
@@ -857,7 +864,11 @@ func compareLob(in, out Lob) (bool, error) {
 		return false, err
 	}
 	// println(len(content))
-	return bytes.Equal(content, out.wr.(*bytes.Buffer).Bytes()), nil
+	b := new(bytes.Buffer)
+	if _, err := out.WriteTo(b); err != nil {
+		return false, err
+	}
+	return bytes.Equal(content, b.Bytes()), nil
 }
 
 func checkLob(ct types.Column, dfv int, in, out any) (bool, error) {
@@ -997,3 +1008,64 @@ func TestDataType(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegerBoundaries(t *testing.T) {
+	t.Parallel()
+
+	db := MT.DB()
+
+	table := RandomIdentifier("integerBoundaries_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, b bigint, d decimal(20,0))", table)); err != nil {
+		t.Fatal(err)
+	}
+	insert := fmt.Sprintf("insert into %s values (?, ?, ?)", table)
+
+	var maxUint64 uint64 = math.MaxUint64
+
+	tests := []struct {
+		b int64
+		d uint64
+	}{
+		{math.MaxInt64, maxUint64},
+		{math.MinInt64, math.MaxInt64 + 1},
+		{0, 0},
+	}
+	for i, test := range tests {
+		if _, err := db.Exec(insert, i, test.b, test.d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// uint64 values exceeding int64 cannot be bound to bigint
+	if _, err := db.Exec(insert, len(tests), maxUint64, 0); err == nil {
+		t.Fatal("error expected")
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select b, d from %s order by i", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	i := 0
+	for rows.Next() {
+		var b int64
+		var d Decimal
+		if err := rows.Scan(&b, &d); err != nil {
+			t.Fatal(err)
+		}
+		if b != tests[i].b {
+			t.Fatalf("row %d: got bigint %d - expected %d", i, b, tests[i].b)
+		}
+		v, exact := d.Int()
+		if !exact || !v.IsUint64() || v.Uint64() != tests[i].d {
+			t.Fatalf("row %d: got decimal %s - expected %d", i, v, tests[i].d)
+		}
+		i++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(tests) {
+		t.Fatalf("number of rows %d - expected %d", i, len(tests))
+	}
+}
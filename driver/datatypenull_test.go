@@ -6,9 +6,11 @@ import (
 	"bytes"
 	"database/sql"
 	"fmt"
+	"math"
 	"math/big"
 	"slices"
 	"testing"
+	"time"
 )
 
 // TestNull tests go1.22 using generic Null type with go-hdb types.
@@ -218,3 +220,148 @@ func TestNullDataType(t *testing.T) { //nolint:gocyclo
 		t.Fatal(err)
 	}
 }
+
+// TestNullGeneric tests binding and scanning of generic Null types end to end.
+func TestNullGeneric(t *testing.T) {
+	t.Parallel()
+
+	type nullRow struct {
+		No      int                  `sql:"no"` // record number
+		Int64   sql.Null[int64]      `sql:"int64"`
+		String  sql.Null[string]     `sql:"string"`
+		Decimal sql.Null[Decimal]    `sql:"decimal"`
+		Time    sql.Null[time.Time]  `sql:"time,timestamp"`
+		TimeRef *sql.Null[time.Time] `sql:"timeref,timestamp"`
+	}
+
+	cmp := func(in, out *nullRow) error {
+		if in.Int64 != out.Int64 {
+			return fmt.Errorf("no %d int64: got %v - expected %v", out.No, out.Int64, in.Int64)
+		}
+		if in.String != out.String {
+			return fmt.Errorf("no %d string: got %v - expected %v", out.No, out.String, in.String)
+		}
+		if in.Decimal.Valid != out.Decimal.Valid || (in.Decimal.Valid && (*big.Rat)(&in.Decimal.V).Cmp((*big.Rat)(&out.Decimal.V)) != 0) {
+			return fmt.Errorf("no %d decimal: got %v - expected %v", out.No, out.Decimal, in.Decimal)
+		}
+		if in.Time.Valid != out.Time.Valid || (in.Time.Valid && !in.Time.V.Equal(out.Time.V)) {
+			return fmt.Errorf("no %d time: got %v - expected %v", out.No, out.Time, in.Time)
+		}
+		if in.TimeRef == nil && out.TimeRef == nil {
+			return nil
+		}
+		if in.TimeRef == nil || out.TimeRef == nil || in.TimeRef.Valid != out.TimeRef.Valid || (in.TimeRef.Valid && !in.TimeRef.V.Equal(out.TimeRef.V)) {
+			return fmt.Errorf("no %d time reference: got %v - expected %v", out.No, out.TimeRef, in.TimeRef)
+		}
+		return nil
+	}
+
+	db := MT.DB()
+
+	timeValue := time.Date(2024, time.February, 29, 12, 30, 15, 0, time.UTC)
+
+	testRows := []*nullRow{
+		{}, // all null values
+		{
+			Int64:   sql.Null[int64]{V: math.MaxInt64, Valid: true},
+			String:  sql.Null[string]{V: "hello go-hdb", Valid: true},
+			Decimal: sql.Null[Decimal]{V: Decimal(*big.NewRat(-1, 4)), Valid: true},
+			Time:    sql.Null[time.Time]{V: timeValue, Valid: true},
+			TimeRef: &sql.Null[time.Time]{V: timeValue, Valid: true},
+		},
+	}
+
+	scanner, err := NewStructScanner[nullRow]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tableName := RandomIdentifier("nullGeneric_")
+	columnDefs, err := scanner.columnDefs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("create table %s %s", tableName, columnDefs)); err != nil {
+		t.Fatal(err)
+	}
+
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values %s", tableName, scanner.queryPlaceholders()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	for i, row := range testRows {
+		if _, err := stmt.Exec(i, row.Int64, row.String, row.Decimal, row.Time, row.TimeRef); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select * from %s order by no", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row := new(nullRow)
+		if err := scanner.Scan(rows, row); err != nil {
+			t.Fatal(err)
+		}
+		if err := cmp(testRows[row.No], row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestNullUUID tests binding nullable UUIDs to binary columns.
+func TestNullUUID(t *testing.T) {
+	t.Parallel()
+
+	db := MT.DB()
+
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	testValues := []sql.Null[UUID]{{V: u, Valid: true}, {}}
+
+	tableName := RandomIdentifier("nullUUID_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (no integer, u binary(16))", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range testValues {
+		if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", tableName), i, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// pointer to nullable UUID
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", tableName), len(testValues), &testValues[0]); err != nil {
+		t.Fatal(err)
+	}
+	testValues = append(testValues, testValues[0])
+
+	rows, err := db.Query(fmt.Sprintf("select u from %s order by no", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	i := 0
+	for rows.Next() {
+		var v sql.Null[UUID]
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		if v != testValues[i] {
+			t.Fatalf("row %d: got %v - expected %v", i, v, testValues[i])
+		}
+		i++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(testValues) {
+		t.Fatalf("number of rows %d - expected %d", i, len(testValues))
+	}
+}
@@ -6,7 +6,14 @@ import (
 	"math/big"
 )
 
-// A Decimal is the driver representation of a database decimal field value as big.Rat.
+/*
+A Decimal is the driver representation of a database decimal field value as big.Rat.
+
+Integer values exceeding the int64 range of BIGINT (e.g. unsigned 64-bit ids greater than math.MaxInt64)
+need to be stored in DECIMAL fields (e.g. DECIMAL(20,0)). These values can be bound as uint64 or *big.Int
+and scanned as Decimal, using Int to retrieve the integer value. Binding such a uint64 value to a BIGINT
+parameter is rejected with an error.
+*/
 type Decimal big.Rat
 
 // Scan implements the database/sql/Scanner interface.
@@ -24,6 +31,18 @@ func (d Decimal) Value() (driver.Value, error) {
 	return (*big.Rat)(&d), nil
 }
 
+// Float64 returns the nearest float64 value for d and a bool indicating whether the float64 value represents d exactly.
+func (d *Decimal) Float64() (float64, bool) { return (*big.Rat)(d).Float64() }
+
+// Int returns the integer part of d (truncated towards zero) and a bool indicating whether d is an integer value.
+func (d *Decimal) Int() (*big.Int, bool) {
+	r := (*big.Rat)(d)
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num()), true
+	}
+	return new(big.Int).Quo(r.Num(), r.Denom()), false
+}
+
 // NullDecimal represents an Decimal that may be null.
 // NullDecimal implements the Scanner interface so
 // it can be used as a scan destination, similar to NullString.
@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestDecimalInt(t *testing.T) {
+	maxUint64 := new(big.Int).SetUint64(math.MaxUint64)
+
+	tests := []struct {
+		r     *big.Rat
+		i     *big.Int
+		exact bool
+	}{
+		{new(big.Rat).SetInt(maxUint64), maxUint64, true},
+		{new(big.Rat).SetInt64(math.MinInt64), big.NewInt(math.MinInt64), true},
+		{big.NewRat(7, 2), big.NewInt(3), false},
+		{big.NewRat(-7, 2), big.NewInt(-3), false},
+		{big.NewRat(0, 1), big.NewInt(0), true},
+	}
+	for _, test := range tests {
+		i, exact := (*Decimal)(test.r).Int()
+		if i.Cmp(test.i) != 0 || exact != test.exact {
+			t.Fatalf("%s: got %s %t - expected %s %t", test.r.RatString(), i, exact, test.i, test.exact)
+		}
+	}
+}
+
+func TestNullDecimal(t *testing.T) {
+	var n NullDecimal
+
+	// scan non NULL value
+	if err := n.Scan(big.NewRat(3, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || (*big.Rat)(n.Decimal).Cmp(big.NewRat(3, 2)) != 0 {
+		t.Fatalf("got %v - expected %s", n, big.NewRat(3, 2))
+	}
+	// scan NULL value
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid {
+		t.Fatal("invalid value expected")
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Fatalf("got %v %v - expected nil", v, err)
+	}
+	// invalid data type
+	if err := n.Scan("1.5"); err == nil {
+		t.Fatal("error expected")
+	}
+	// valid value without decimal
+	if _, err := (NullDecimal{Valid: true}).Value(); err == nil {
+		t.Fatal("error expected")
+	}
+}
@@ -118,8 +118,9 @@ func OpenDB(c *Connector) *DB {
 
 // Close closes the database. It also calls the Close method of the sql package and returns its error.
 func (db *DB) Close() error {
+	err := db.DB.Close() // close connections first, so that their metrics are collected before the flush
 	db.metrics.close()
-	return db.DB.Close()
+	return err
 }
 
 // ExStats returns the extended database statistics.
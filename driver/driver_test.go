@@ -7,7 +7,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/SAP/go-hdb/driver"
@@ -38,22 +40,38 @@ func testPing(t *testing.T, db *sql.DB) {
 
 func testInsertByQuery(t *testing.T, db *sql.DB) {
 	table := driver.RandomIdentifier("insertByQuery_")
-	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer)", table)); err != nil {
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer primary key, j integer)", table)); err != nil {
 		t.Fatal(err)
 	}
 
-	// insert value via Query
-	if err := db.QueryRow(fmt.Sprintf("insert into %s values (?)", table), 42).Scan(); err != sql.ErrNoRows {
-		t.Fatal(err)
+	// data manipulation statements executed via Query do not return rows but are applied.
+	tests := []struct {
+		name  string
+		query string
+		args  []any
+		sum   int
+	}{
+		{"insert", fmt.Sprintf("insert into %s values (?, ?)", table), []any{42, 1}, 1},
+		{"insertDirect", fmt.Sprintf("insert into %s values (43, 2)", table), nil, 3},
+		{"upsert", fmt.Sprintf("upsert %s values (?, ?) with primary key", table), []any{42, 10}, 12},
+		{"upsertDirect", fmt.Sprintf("upsert %s values (44, 3) with primary key", table), nil, 15},
+		{"delete", fmt.Sprintf("delete from %s where i = ?", table), []any{43}, 13},
+		{"deleteDirect", fmt.Sprintf("delete from %s where i = 44", table), nil, 10},
+		{"deleteNoRows", fmt.Sprintf("delete from %s where i = ?", table), []any{99}, 10},
 	}
 
-	// check value
-	var i int
-	if err := db.QueryRow(fmt.Sprintf("select * from %s", table)).Scan(&i); err != nil {
-		t.Fatal(err)
-	}
-	if i != 42 {
-		t.Fatalf("value %d - expected %d", i, 42)
+	for _, test := range tests {
+		if err := db.QueryRow(test.query, test.args...).Scan(); err != sql.ErrNoRows {
+			t.Fatalf("%s: got error %v - expected %v", test.name, err, sql.ErrNoRows)
+		}
+		// check values
+		var sum int
+		if err := db.QueryRow(fmt.Sprintf("select sum(j) from %s", table)).Scan(&sum); err != nil {
+			t.Fatal(err)
+		}
+		if sum != test.sum {
+			t.Fatalf("%s: sum %d - expected %d", test.name, sum, test.sum)
+		}
 	}
 }
 
@@ -97,6 +115,63 @@ end
 	}
 }
 
+func testAlterSystem(t *testing.T, db *sql.DB) {
+	const procWarning = `create procedure %[1]s ()
+language SQLSCRIPT as
+begin
+	exec 'create table %[2]s(id int)';
+	exec 'drop table %[2]s';
+end
+`
+	var mu sync.Mutex
+	var warnings []driver.DBError
+
+	connector := driver.MT.NewConnector()
+	connector.SetWarningHandler(func(warning driver.DBError) {
+		mu.Lock()
+		defer mu.Unlock()
+		warnings = append(warnings, warning)
+	})
+	warningDB := sql.OpenDB(connector)
+	defer warningDB.Close()
+
+	// warnings are routed through the warning handler and do not fail the statement.
+	procedure := driver.RandomIdentifier("proc_")
+	if _, err := warningDB.Exec(fmt.Sprintf(procWarning, procedure, driver.RandomIdentifier("table_"))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := warningDB.Exec(fmt.Sprintf("call %s", procedure)); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	numWarning := len(warnings)
+	mu.Unlock()
+	if numWarning == 0 {
+		t.Log("no warning returned by database server") // dependent on dynamic_sql_ddl_error_level
+	}
+	for _, warning := range warnings {
+		if !warning.IsWarning() {
+			t.Fatalf("warning %s: warning level expected", warning)
+		}
+	}
+
+	result, err := warningDB.Exec("alter system clear sql plan cache")
+	if err != nil {
+		if driver.IsInsufficientPrivilege(err) {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n < 0 { // error in case of DDL function code
+		t.Fatalf("rows affected %d - expected >= 0", n)
+	}
+	// connection is still usable.
+	var i int
+	if err := warningDB.QueryRow("select 1 from dummy").Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testQueryAttributeAlias(t *testing.T, db *sql.DB) {
 	table := driver.RandomIdentifier("queryAttributeAlias_")
 	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, j integer)", table)); err != nil {
@@ -123,13 +198,13 @@ func testQueryAttributeAlias(t *testing.T, db *sql.DB) {
 	}
 }
 
-func checkAffectedRows(t *testing.T, result sql.Result, rowsExpected int64) {
+func checkAffectedRows(tb testing.TB, result sql.Result, rowsExpected int64) {
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		t.Fatal(err)
+		tb.Fatal(err)
 	}
 	if rowsAffected != rowsExpected {
-		t.Fatalf("rows affected %d - expected %d", rowsAffected, rowsExpected)
+		tb.Fatalf("rows affected %d - expected %d", rowsAffected, rowsExpected)
 	}
 }
 
@@ -164,6 +239,52 @@ func testRowsAffected(t *testing.T, db *sql.DB) {
 	checkAffectedRows(t, result, maxRows)
 }
 
+func BenchmarkInsert(b *testing.B) {
+	db := driver.MT.DB()
+
+	table := driver.RandomIdentifier("benchmarkInsert_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer)", table)); err != nil {
+		b.Fatal(err)
+	}
+
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values(?)", table))
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer stmt.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result, err := stmt.Exec(i)
+		if err != nil {
+			b.Fatal(err)
+		}
+		checkAffectedRows(b, result, 1)
+	}
+}
+
+func BenchmarkQuery(b *testing.B) {
+	db := driver.MT.DB()
+
+	query := func(b *testing.B, ctx context.Context) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var v int
+			if err := db.QueryRowContext(ctx, "select 1 from dummy").Scan(&v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("non-cancelable context", func(b *testing.B) { query(b, context.Background()) })
+	b.Run("cancelable context", func(b *testing.B) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		query(b, ctx)
+	})
+}
+
 func testUpsert(t *testing.T, db *sql.DB) {
 	table := driver.RandomIdentifier("upsert_")
 	if _, err := db.Exec(fmt.Sprintf("create table %s (key int primary key, val int)", table)); err != nil {
@@ -201,6 +322,108 @@ func testUpsert(t *testing.T, db *sql.DB) {
 	checkAffectedRows(t, result, 2)
 }
 
+func testMerge(t *testing.T, db *sql.DB) {
+	target := driver.RandomIdentifier("mergeTarget_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (key int primary key, val int)", target)); err != nil {
+		t.Fatal(err)
+	}
+	source := driver.RandomIdentifier("mergeSource_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (key int primary key, val int)", source)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", target), 1, 1, 2, 2, 3, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", source), 2, 20, 3, 30, 4, 40, 5, 50); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2 rows updated (keys 2, 3) and 2 rows inserted (keys 4, 5): the database server reports the total only.
+	result, err := db.Exec(fmt.Sprintf(`merge into %[1]s using %[2]s on %[1]s.key = %[2]s.key
+when matched then update set %[1]s.val = %[2]s.val
+when not matched then insert values (%[2]s.key, %[2]s.val)`, target, source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkAffectedRows(t, result, 4)
+
+	var sum int
+	if err := db.QueryRow(fmt.Sprintf("select sum(val) from %s", target)).Scan(&sum); err != nil {
+		t.Fatal(err)
+	}
+	if sum != 1+20+30+40+50 {
+		t.Fatalf("sum %d - expected %d", sum, 1+20+30+40+50)
+	}
+
+	// upsert batch: row count of inserted (key 6) and updated (key 1) rows are both 1.
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	batchResult, err := driver.ExecBatch(context.Background(), conn, fmt.Sprintf("upsert %s values (?, ?) with primary key", target), 6, 6, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rowCounts := batchResult.RowCounts(); !slices.Equal(rowCounts, []int64{1, 1}) {
+		t.Fatalf("row counts %v - expected %v", rowCounts, []int64{1, 1})
+	}
+}
+
+func testSetBasedDML(t *testing.T, db *sql.DB) {
+	const numRow = 1000
+
+	src := driver.RandomIdentifier("setBasedDMLSrc_")
+	dst := driver.RandomIdentifier("setBasedDMLDst_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (key int primary key, val int)", src)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (key int primary key, val int)", dst)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s select top %d row_number() over (), 0 from objects", src, numRow)); err != nil {
+		t.Fatal(err)
+	}
+	var cnt int64
+	if err := db.QueryRow(fmt.Sprintf("select count(*) from %s", src)).Scan(&cnt); err != nil {
+		t.Fatal(err)
+	}
+	if cnt == 0 {
+		t.Skip("no source rows available")
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		args         []any
+		rowsAffected int64
+	}{
+		{"insertSelectNoRow", fmt.Sprintf("insert into %s select * from %s where key < 0", dst, src), nil, 0},
+		{"insertSelectOneRow", fmt.Sprintf("insert into %s select * from %s where key = 1", dst, src), nil, 1},
+		{"insertSelectManyRows", fmt.Sprintf("insert into %s select * from %s where key > 1", dst, src), nil, cnt - 1},
+		{"insertSelectPreparedNoRow", fmt.Sprintf("insert into %s select * from %s where key < ?", dst, src), []any{0}, 0},
+		{"updateFromNoRow", fmt.Sprintf("update %s set val = s.val + 1 from %s, %s s where %s.key = s.key and s.key < 0", dst, dst, src, dst), nil, 0},
+		{"updateFromOneRow", fmt.Sprintf("update %s set val = s.val + 1 from %s, %s s where %s.key = s.key and s.key = 1", dst, dst, src, dst), nil, 1},
+		{"updateFromManyRows", fmt.Sprintf("update %s set val = s.val + 2 from %s, %s s where %s.key = s.key", dst, dst, src, dst), nil, cnt},
+		{"updateFromPrepared", fmt.Sprintf("update %s set val = s.val + ? from %s, %s s where %s.key = s.key and s.key <= ?", dst, dst, src, dst), []any{3, 1}, 1},
+		{"deleteSubselect", fmt.Sprintf("delete from %s where key in (select key from %s)", dst, src), nil, cnt},
+	}
+
+	for _, test := range tests { // sequential as the statements depend on each other
+		result, err := db.Exec(test.query, test.args...)
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if rowsAffected != test.rowsAffected {
+			t.Fatalf("%s: rows affected %d - expected %d", test.name, rowsAffected, test.rowsAffected)
+		}
+	}
+}
+
 func testQueryArgs(t *testing.T, db *sql.DB) {
 	table := driver.RandomIdentifier("table_")
 	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, j integer)", table)); err != nil {
@@ -217,6 +440,62 @@ func testQueryArgs(t *testing.T, db *sql.DB) {
 	if err := db.QueryRow(fmt.Sprintf("select count(*) from %s where i = ? and j = :3", table), 1, "arg not used", 2).Scan(&i); err != nil {
 		t.Fatal(err)
 	}
+
+	// named placeholders bound positionally in order of first appearance
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", table), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	var j int
+	if err := db.QueryRow(fmt.Sprintf("select i, j from %s where j = :b and i = :a and i < :b", table), 2, 1).Scan(&i, &j); err != nil {
+		t.Fatal(err)
+	}
+	if i != 1 || j != 2 {
+		t.Fatalf("values %d %d - expected %d %d", i, j, 1, 2)
+	}
+	// a repeated name refers to the same argument
+	if _, err := db.Query(fmt.Sprintf("select i from %s where j = :b and i = :a and i < :b", table), 2, 1, 2); err == nil {
+		t.Fatal("invalid number of arguments error expected")
+	}
+
+	// named args bound by name
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (:i, :j)", table), sql.Named("j", 4), sql.Named("i", 3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow(fmt.Sprintf("select i, j from %s where j = :b and i = :a and i < :b", table), sql.Named("a", 3), sql.Named("b", 4)).Scan(&i, &j); err != nil {
+		t.Fatal(err)
+	}
+	if i != 3 || j != 4 {
+		t.Fatalf("values %d %d - expected %d %d", i, j, 3, 4)
+	}
+	if _, err := db.Query(fmt.Sprintf("select i from %s where j = :b and i = :a", table), sql.Named("a", 3), sql.Named("bb", 4)); err == nil || !strings.Contains(err.Error(), "did you mean b?") { //nolint:sqlclosecheck
+		t.Fatalf("got error %v - expected invalid argument name error", err)
+	}
+}
+
+func testTableArg(t *testing.T, db *sql.DB) {
+	type row struct {
+		A string
+		B int
+	}
+	rows := []row{{"X", 1}, {"Y", 2}}
+	for _, query := range []string{
+		"select * from table(?)",                    // table variable
+		"select * from dummy where dummy = ?",       // scalar parameter
+		"select * from dummy, table(?) where b = 1", // join
+	} {
+		if _, err := db.Query(query, rows); !errors.Is(err, driver.ErrTableBindNotSupported) { //nolint:sqlclosecheck
+			t.Fatalf("query %s: got error %v - expected %v", query, err, driver.ErrTableBindNotSupported)
+		}
+	}
+	// prepared statement: the parameter does not expect a table
+	stmt, err := db.Prepare("select * from dummy where dummy = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+	if _, err := stmt.Query(rows); !errors.Is(err, driver.ErrTableBindNotSupported) { //nolint:sqlclosecheck
+		t.Fatalf("got error %v - expected %v", err, driver.ErrTableBindNotSupported)
+	}
 }
 
 func testComments(t *testing.T, db *sql.DB) {
@@ -244,6 +523,87 @@ func testComments(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testStatementTagger(t *testing.T, db *sql.DB) {
+	const tag = "statement-tagger-test"
+
+	connector := driver.MT.NewConnector()
+	connector.SetStatementTagger(func(ctx context.Context) string { return tag })
+	taggedDB := sql.OpenDB(connector)
+	defer taggedDB.Close()
+
+	// the executing statement is part of the active statements of the connection.
+	var statement string
+	if err := taggedDB.QueryRow("select statement_string from m_active_statements where connection_id = current_connection and statement_string like ?", "%m_active_statements%").Scan(&statement); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(statement, "/* "+tag+" */ ") {
+		t.Fatalf("statement %q does not start with tag %q", statement, tag)
+	}
+}
+
+func testStatementFilter(t *testing.T, db *sql.DB) {
+	const tag = "statement-filter-test"
+
+	errDenied := errors.New("statement denied")
+
+	table := driver.RandomIdentifier("statementFilter_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var queries []string
+
+	connector := driver.MT.NewConnector()
+	connector.SetStatementTagger(func(ctx context.Context) string { return tag })
+	connector.SetStatementFilter(func(query string) error {
+		mu.Lock()
+		queries = append(queries, query)
+		mu.Unlock()
+		if strings.Contains(strings.ToLower(query), "drop ") {
+			return errDenied
+		}
+		return nil
+	})
+	filteredDB := sql.OpenDB(connector)
+	defer filteredDB.Close()
+
+	// allowed statements
+	if _, err := filteredDB.Exec(fmt.Sprintf("insert into %s values (?)", table), 1); err != nil {
+		t.Fatal(err)
+	}
+	var i int
+	if err := filteredDB.QueryRow(fmt.Sprintf("select i from %s", table)).Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+	if i != 1 {
+		t.Fatalf("value %d - expected %d", i, 1)
+	}
+
+	// denied statements (direct and prepared)
+	drop := fmt.Sprintf("drop table %s", table)
+	if _, err := filteredDB.Exec(drop); !errors.Is(err, errDenied) {
+		t.Fatalf("got error %v - expected %v", err, errDenied)
+	}
+	if _, err := filteredDB.Prepare(drop); !errors.Is(err, errDenied) {
+		t.Fatalf("got error %v - expected %v", err, errDenied)
+	}
+
+	// table still exists and the connection is still usable
+	if err := filteredDB.QueryRow(fmt.Sprintf("select count(*) from %s", table)).Scan(&i); err != nil {
+		t.Fatal(err)
+	}
+
+	// the filter is called after rewriting
+	mu.Lock()
+	defer mu.Unlock()
+	for _, query := range queries {
+		if !strings.HasPrefix(query, "/* "+tag+" */ ") {
+			t.Fatalf("filtered statement %q does not start with tag %q", query, tag)
+		}
+	}
+}
+
 func TestDriver(t *testing.T) {
 	t.Parallel()
 
@@ -256,11 +616,17 @@ func TestDriver(t *testing.T) {
 		{"insertByQuery", testInsertByQuery},
 		{"hdbError", testHDBError},
 		{"hdbWarning", testHDBWarning},
+		{"alterSystem", testAlterSystem},
 		{"queryAttributeAlias", testQueryAttributeAlias},
 		{"rowsAffected", testRowsAffected},
 		{"upsert", testUpsert},
+		{"merge", testMerge},
+		{"setBasedDML", testSetBasedDML},
 		{"queryArgs", testQueryArgs},
 		{"queryComments", testComments},
+		{"tableArg", testTableArg},
+		{"statementTagger", testStatementTagger},
+		{"statementFilter", testStatementFilter},
 	}
 
 	db := driver.MT.DB()
@@ -0,0 +1,43 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"sync"
+
+	hdbreflect "github.com/SAP/go-hdb/driver/internal/reflect"
+)
+
+// encoders stores the registered encoder functions by type (reflect.Type -> func(v any) (driver.Value, error)).
+var encoders sync.Map
+
+/*
+RegisterEncoder registers the encoder function fn for statement arguments of type T (and *T).
+This allows binding values of types not implementing the driver.Valuer interface, e.g. custom types
+implementing the sql.Scanner interface only, symmetrically to the scanning of output values.
+The value returned by fn is converted like any other argument value.
+
+Precedence:
+  - in case T (or *T) implements the driver.Valuer interface, the Valuer is used and the encoder is ignored
+  - registering an encoder for an already registered type replaces the previous encoder
+
+RegisterEncoder is safe for concurrent use, but encoders are meant to be registered once
+during package initialization.
+*/
+func RegisterEncoder[T any](fn func(v T) (driver.Value, error)) {
+	encoders.Store(hdbreflect.TypeFor[T](), func(v any) (driver.Value, error) { return fn(v.(T)) })
+}
+
+// encoder returns the encoder function registered for the type of arg and the (dereferenced) arg.
+func encoder(arg driver.Value) (func(v any) (driver.Value, error), driver.Value, bool) {
+	rt := reflect.TypeOf(arg)
+	if fn, ok := encoders.Load(rt); ok {
+		return fn.(func(v any) (driver.Value, error)), arg, true
+	}
+	if rt.Kind() == reflect.Ptr { // non nil pointers (nil values are checked by caller)
+		if fn, ok := encoders.Load(rt.Elem()); ok {
+			return fn.(func(v any) (driver.Value, error)), reflect.ValueOf(arg).Elem().Interface(), true
+		}
+	}
+	return nil, nil, false
+}
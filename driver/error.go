@@ -1,7 +1,14 @@
 package driver
 
 import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+
 	p "github.com/SAP/go-hdb/driver/internal/protocol"
+	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
 )
 
 // HDB error levels.
@@ -13,27 +20,120 @@ const (
 
 // DBError represents a single error returned by the database server.
 type DBError interface {
-	Error() string   // Implements the golang error interface.
-	StmtNo() int     // Returns the statement number of the error in multi statement contexts (e.g. bulk insert).
-	Code() int       // Code return the database error code.
-	Position() int   // Position returns the start position of erroneous sql statements sent to the database server.
-	Level() int      // Level return one of the database server predefined error levels.
-	Text() string    // Text return the error description sent from database server.
-	IsWarning() bool // IsWarning returns true if the HDB error level equals 0.
-	IsError() bool   // IsError returns true if the HDB error level equals 1.
-	IsFatal() bool   // IsFatal returns true if the HDB error level equals 2.
-}
-
-// Error represents errors (an error collection) send by the database server.
+	Error() string          // Implements the golang error interface.
+	StmtNo() int            // Returns the statement number of the error in multi statement contexts (e.g. bulk insert).
+	Code() int              // Code return the database error code.
+	Position() int          // Position returns the start position of erroneous sql statements sent to the database server.
+	Level() int             // Level return one of the database server predefined error levels.
+	Text() string           // Text return the error description sent from database server.
+	Details() *ErrorDetails // Details returns structured information like the missing privilege and object name extracted from the error text.
+	IsWarning() bool        // IsWarning returns true if the HDB error level equals 0.
+	IsError() bool          // IsError returns true if the HDB error level equals 1.
+	IsFatal() bool          // IsFatal returns true if the HDB error level equals 2.
+}
+
+/*
+Error represents errors (an error collection) send by the database server.
+
+The error classification functions consider all errors of an error collection (independent of SetIdx):
+  - IsRetriable returns true if all errors (ignoring warnings) are retriable, e.g. a lock wait timeout,
+    a deadlock or a connection error, so that the statement (or transaction) might succeed if executed again.
+  - IsTransactionRollback returns true if any error reports that the transaction got rolled back by the database server.
+  - IsConnectionError returns true if any error reports that the connection (database session) is not usable anymore.
+*/
 type Error interface {
-	Error() string   // Implements the golang error interface.
-	NumError() int   // NumError returns the number of errors.
-	Unwrap() []error // Unwrap implements the standard error Unwrap function for errors wrapping multiple errors.
-	SetIdx(idx int)  // SetIdx sets the error index in case number of errors are greater 1 in the range of 0 <= index < NumError().
-	DBError          // DBError functions for error in case of single error, for error set by SetIdx in case of error collection.
+	Error() string               // Implements the golang error interface.
+	NumError() int               // NumError returns the number of errors.
+	Unwrap() []error             // Unwrap implements the standard error Unwrap function for errors wrapping multiple errors.
+	SetIdx(idx int)              // SetIdx sets the error index in case number of errors are greater 1 in the range of 0 <= index < NumError().
+	IsRetriable() bool           // IsRetriable returns true if the error(s) are transient and the execution might be retried.
+	IsTransactionRollback() bool // IsTransactionRollback returns true if the transaction got rolled back by the database server.
+	IsConnectionError() bool     // IsConnectionError returns true if the connection (database session) is not usable anymore.
+	DBError                      // DBError functions for error in case of single error, for error set by SetIdx in case of error collection.
 }
 
+// ErrorDetails provides structured information extracted from the database error text.
+type ErrorDetails = p.HdbErrorDetails
+
 var (
 	_ DBError = (*p.HdbError)(nil)
 	_ Error   = (*p.HdbErrors)(nil)
 )
+
+// IsInsufficientPrivilege returns true if err is a database error reporting an insufficient privilege (authorization failure), false otherwise.
+// Please use DBError.Details to retrieve the missing privilege and object name if provided by the database server.
+func IsInsufficientPrivilege(err error) bool {
+	var dbErr DBError
+	if !errors.As(err, &dbErr) {
+		return false
+	}
+	return dbErr.Code() == p.HdbErrInsufficientPrivilege
+}
+
+// IsAuthenticationFailed returns true if err is a database error reporting a failed authentication
+// (e.g. an invalid password or an invalid or expired JWT token), false otherwise.
+func IsAuthenticationFailed(err error) bool {
+	var dbErr DBError
+	if !errors.As(err, &dbErr) {
+		return false
+	}
+	return dbErr.Code() == p.HdbErrAuthenticationFailed
+}
+
+// IsCertificateInvalid returns true if err is reporting an invalid X509 authentication client certificate
+// (e.g. an expired certificate or an invalid certificate chain) detected before the authentication, false otherwise.
+func IsCertificateInvalid(err error) bool {
+	var certValidationError *auth.CertValidationError
+	return errors.As(err, &certValidationError)
+}
+
+// IsStatementMemoryLimit returns true if err is a database error reporting that a statement was aborted
+// because of exceeding the statement memory limit (see WithStatementMemoryLimit), false otherwise.
+func IsStatementMemoryLimit(err error) bool {
+	var dbErr DBError
+	if !errors.As(err, &dbErr) {
+		return false
+	}
+	return dbErr.Code() == p.HdbErrAllocationFailed && strings.Contains(strings.ToLower(dbErr.Text()), "statement memory limit")
+}
+
+// IsSessionInvalidated returns true if err is a database error reporting that the database session is not valid
+// anymore (e.g. after a takeover during database maintenance), false otherwise (see Error.IsConnectionError).
+// The connection of an invalidated session is reported as bad to database/sql, so that subsequent statements
+// are executed on a new connection (session).
+func IsSessionInvalidated(err error) bool {
+	var hdbErr Error
+	return errors.As(err, &hdbErr) && hdbErr.IsConnectionError()
+}
+
+/*
+IsConnectionError returns true if err reports that the connection to the database server got lost or that the
+database session is not usable anymore (e.g. a network error, a database server restart or an invalidated session),
+false otherwise. Cancellations of database calls by a context are not reported as connection errors.
+Connections are re-established according to the reconnect policy of the connector (see Connector.SetReconnectPolicy).
+*/
+func IsConnectionError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errCancelled) {
+		return false
+	}
+	var netErr net.Error
+	var noRetryErr *noRetryError
+	if errors.Is(err, driver.ErrBadConn) || errors.As(err, &netErr) || errors.As(err, &noRetryErr) {
+		return true
+	}
+	var hdbErr Error
+	return errors.As(err, &hdbErr) && hdbErr.IsConnectionError()
+}
+
+// IsStatementInvalidated returns true if err is a database error reporting that a prepared statement got invalidated
+// (e.g. by a DDL statement altering a table referenced by the statement), false otherwise.
+// Statements are re-prepared and re-executed once transparently by the driver in case of queries and
+// single row execs, so that the error is only returned if re-preparing does not succeed.
+func IsStatementInvalidated(err error) bool {
+	var dbErr DBError
+	if !errors.As(err, &dbErr) {
+		return false
+	}
+	text := strings.ToLower(dbErr.Text())
+	return strings.Contains(text, "statement") && (strings.Contains(text, "invalidated") || strings.Contains(text, "invalid statement id"))
+}
@@ -0,0 +1,53 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestInsufficientPrivilege(t *testing.T) {
+	t.Parallel()
+
+	const password = "Go_hdb_Test_1"
+
+	db := MT.DB()
+
+	tableName := RandomIdentifier("privilege_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	username := RandomIdentifier("USER_")
+	if _, err := db.Exec(fmt.Sprintf("create user %s password %s no force_first_password_change", username, password)); err != nil {
+		t.Fatal(err)
+	}
+	defer db.Exec(fmt.Sprintf("drop user %s cascade", username)) //nolint:errcheck
+
+	ctr := MT.NewConnector()
+	ctr.authAttrs.mu.Lock()
+	ctr._username = string(username)
+	ctr.authAttrs.mu.Unlock()
+	ctr.SetPassword(password)
+	schemaName := ctr.DefaultSchema()
+	ctr.SetDefaultSchema("")
+
+	userDB := sql.OpenDB(ctr)
+	defer userDB.Close()
+
+	_, err := userDB.Query(fmt.Sprintf("select * from %s.%s", Identifier(schemaName), tableName))
+	if !IsInsufficientPrivilege(err) {
+		t.Fatalf("expected insufficient privilege error - got %v", err)
+	}
+	var dbErr DBError
+	if !errors.As(err, &dbErr) {
+		t.Fatalf("expected DBError - got %T", err)
+	}
+	details := dbErr.Details()
+	if details.GUID == "" && details.Privilege == "" {
+		t.Fatalf("no error details found in error text %s", dbErr.Text())
+	}
+}
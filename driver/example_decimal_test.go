@@ -42,3 +42,62 @@ func ExampleDecimal() {
 
 	// output: Decimal value: 1/1
 }
+
+/*
+ExampleNullDecimal creates a table with a nullable decimal attribute, inserts a NULL and a non NULL value
+and scans the records into a struct afterwards.
+This demonstrates the usage of the type NullDecimal to distinguish NULL from zero decimal values.
+*/
+func ExampleNullDecimal() {
+	db := sql.OpenDB(driver.MT.Connector())
+	defer db.Close()
+
+	tableName := driver.RandomIdentifier("table_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, x decimal(10,2))", tableName)); err != nil { // Create table with nullable decimal attribute.
+		log.Panic(err)
+	}
+
+	for i, in := range []driver.NullDecimal{
+		{Valid: false}, // NULL value.
+		{Valid: true, Decimal: (*driver.Decimal)(new(big.Rat))}, // zero value.
+	} {
+		if _, err := db.Exec(fmt.Sprintf("insert into %s values(?, ?)", tableName), i, in); err != nil { // Insert record.
+			log.Panic(err)
+		}
+	}
+
+	type record struct {
+		I int
+		X driver.NullDecimal
+	}
+
+	scanner, err := driver.NewStructScanner[record]()
+	if err != nil {
+		log.Panic(err)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select * from %s order by i", tableName))
+	if err != nil {
+		log.Panic(err)
+	}
+	defer rows.Close()
+
+	var r record
+	for rows.Next() {
+		if err := scanner.Scan(rows, &r); err != nil {
+			log.Panic(err)
+		}
+		if r.X.Valid {
+			fmt.Printf("%d: %s\n", r.I, (*big.Rat)(r.X.Decimal).String())
+		} else {
+			fmt.Printf("%d: NULL\n", r.I)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		log.Panic(err)
+	}
+
+	// output: 0: NULL
+	// 1: 0/1
+}
@@ -0,0 +1,27 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Exists returns true if query with arguments args returns at least one row.
+// The query is wrapped into a top 1 subquery, so that at most a single row is returned by the execute reply
+// and no further fetch request is needed. This replaces a single-row execute option, which is not supported
+// by the driver. Please note that the query must be a single select statement, e.g.
+//
+//	found, err := Exists(ctx, db, "select * from t where a = ? and b > ?", 1, 2)
+func Exists(ctx context.Context, q Queryer, query string, args ...any) (bool, error) {
+	// closing parenthesis on a new line: a trailing line comment of query must not comment it out.
+	rows, err := q.QueryContext(ctx, fmt.Sprintf("select top 1 1 from (%s\n)", strings.TrimRight(query, " \t\r\n;")), args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return false, rows.Err()
+	}
+	return true, rows.Close()
+}
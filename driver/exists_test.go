@@ -0,0 +1,52 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestExists(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	table := RandomIdentifier("exists_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, s nvarchar(10))", table)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?, ?)", table), 1, "a", 2, "b", 2, "c"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query    string
+		args     []any
+		expected bool
+	}{
+		{fmt.Sprintf("select * from %s where i = ?", table), []any{2}, true},
+		{fmt.Sprintf("select * from %s where i = ? and s = ?", table), []any{1, "a"}, true},
+		{fmt.Sprintf("select * from %s where i = ? and s = ?;", table), []any{1, "b"}, false},
+		{fmt.Sprintf("select * from %s where i > 10", table), nil, false},
+		{fmt.Sprintf("select * from %s where i = ? -- trailing comment", table), []any{2}, true},
+		{fmt.Sprintf("select * from %s where i = ? -- trailing comment\n", table), []any{3}, false},
+	}
+
+	for _, test := range tests {
+		found, err := Exists(ctx, db, test.query, test.args...)
+		if err != nil {
+			t.Fatalf("%s: %s", test.query, err)
+		}
+		if found != test.expected {
+			t.Fatalf("%s %v: got %t - expected %t", test.query, test.args, found, test.expected)
+		}
+	}
+
+	// invalid query
+	if _, err := Exists(ctx, db, "select * from not_existing_table_x"); err == nil {
+		t.Fatal("error expected")
+	}
+}
@@ -0,0 +1,51 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// ExplainPlan returns the execution plan of query as formatted text.
+// The plan is computed by 'EXPLAIN PLAN' using a random statement name and read back from the EXPLAIN_PLAN_TABLE.
+// The plan table entries are deleted afterwards.
+func ExplainPlan(ctx context.Context, conn *sql.Conn, query string, args ...any) (string, error) {
+	statementName := string(RandomIdentifier("explain_"))
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("explain plan set statement_name = '%s' for %s", statementName, query), args...); err != nil {
+		return "", err
+	}
+	defer conn.ExecContext(context.Background(), "delete from explain_plan_table where statement_name = ?", statementName) //nolint:errcheck
+
+	rows, err := conn.QueryContext(ctx, "select operator_name, operator_details, table_name, level from explain_plan_table where statement_name = ? order by operator_id", statementName)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var b strings.Builder
+	for rows.Next() {
+		var operatorName, operatorDetails, tableName sql.NullString
+		var level int
+		if err := rows.Scan(&operatorName, &operatorDetails, &tableName, &level); err != nil {
+			return "", err
+		}
+		b.WriteString(strings.Repeat("  ", max(level-1, 0)))
+		b.WriteString(operatorName.String)
+		if operatorDetails.String != "" {
+			b.WriteString(" ")
+			b.WriteString(operatorDetails.String)
+		}
+		if tableName.String != "" {
+			b.WriteString(" [")
+			b.WriteString(tableName.String)
+			b.WriteString("]")
+		}
+		b.WriteString("\n")
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
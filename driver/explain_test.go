@@ -0,0 +1,39 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestExplainPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	conn, err := MT.DB().Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	plan, err := ExplainPlan(ctx, conn, "select * from dummy where dummy = ?", "X")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(plan, "DUMMY") {
+		t.Fatalf("plan does not contain table name DUMMY: %s", plan)
+	}
+
+	// plan table entries are deleted
+	pattern := EscapeLike("explain_", '\\') + "%"
+	var cnt int
+	if err := conn.QueryRowContext(ctx, "select count(*) from explain_plan_table where statement_name like ? escape '\\'", pattern).Scan(&cnt); err != nil {
+		t.Fatal(err)
+	}
+	if cnt != 0 {
+		t.Fatalf("number of plan table entries %d - expected 0", cnt)
+	}
+}
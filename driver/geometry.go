@@ -0,0 +1,67 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/SAP/go-hdb/driver/spatial"
+)
+
+const ewkbSRIDFlag uint32 = 0x20000000
+
+/*
+A Geometry is the driver representation of a database spatial field value (ST_GEOMETRY, ST_POINT) in
+'well known binary' (WKB) or 'extended well known binary' (EWKB) format.
+A nil Geometry represents the database NULL value.
+
+Spatial field values are transferred in hex representation, so that geometries exceeding the lob
+size threshold can be used as parameters and scan destinations as well.
+Please note that Geometry is not applicable for database functions expecting a binary parameter
+(e.g. st_geomfromwkb(?)) - please use []byte(g) or a Lob instead.
+*/
+type Geometry []byte
+
+// Scan implements the database/sql/Scanner interface.
+func (g *Geometry) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*g = nil
+	case string:
+		b, err := hex.DecodeString(src)
+		if err != nil {
+			return fmt.Errorf("geometry: %w", err)
+		}
+		*g = b
+	case []byte:
+		*g = append((*g)[:0], src...)
+	default:
+		return fmt.Errorf("geometry: invalid data type %T", src)
+	}
+	return nil
+}
+
+// Value implements the database/sql/Valuer interface.
+func (g Geometry) Value() (driver.Value, error) {
+	if g == nil {
+		return nil, nil
+	}
+	return hex.EncodeToString(g), nil
+}
+
+// SRID returns the spatial reference system identifier of an EWKB encoded geometry and true,
+// or false if the geometry does not contain a spatial reference system identifier.
+func (g Geometry) SRID() (int32, bool) {
+	if len(g) < 9 {
+		return 0, false
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if g[0] == spatial.XDR {
+		order = binary.BigEndian
+	}
+	if order.Uint32(g[1:5])&ewkbSRIDFlag == 0 {
+		return 0, false
+	}
+	return int32(order.Uint32(g[5:9])), true
+}
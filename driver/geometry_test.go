@@ -0,0 +1,67 @@
+package driver
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/spatial"
+)
+
+func TestGeometry(t *testing.T) {
+	point := spatial.Point{X: 1, Y: 2}
+
+	for _, isXDR := range []bool{false, true} {
+		ewkb, err := spatial.EncodeEWKB(point, isXDR, 4326)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var g Geometry
+		if err := g.Scan(string(ewkb)); err != nil { // hex representation
+			t.Fatal(err)
+		}
+		if srid, ok := g.SRID(); !ok || srid != 4326 {
+			t.Fatalf("xdr %t: got srid %d %t - expected %d", isXDR, srid, ok, 4326)
+		}
+		v, err := g.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != string(ewkb) {
+			t.Fatalf("xdr %t: got value %v - expected %s", isXDR, v, ewkb)
+		}
+	}
+
+	wkb, err := spatial.EncodeWKB(point, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := hex.DecodeString(string(wkb))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var g Geometry
+	if err := g.Scan(b); err != nil { // binary representation
+		t.Fatal(err)
+	}
+	if !bytes.Equal(g, b) {
+		t.Fatalf("got %x - expected %x", g, b)
+	}
+	if _, ok := g.SRID(); ok {
+		t.Fatal("no srid expected")
+	}
+
+	// NULL
+	if err := g.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := g.Value(); g != nil || v != nil || err != nil {
+		t.Fatalf("got %v %v %v - expected nil", g, v, err)
+	}
+
+	for _, src := range []any{"xyz", int64(42)} {
+		if err := g.Scan(src); err == nil {
+			t.Fatalf("%v: error expected", src)
+		}
+	}
+}
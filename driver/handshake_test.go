@@ -0,0 +1,86 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// stallingServer accepts connections, answers the protocol prolog and stalls afterwards (authentication).
+func stallingServer(t *testing.T) string {
+	const (
+		initRequestSize = 14
+		initReplySize   = 8
+	)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if _, err := io.ReadFull(conn, make([]byte, initRequestSize)); err != nil {
+					return
+				}
+				if _, err := conn.Write(make([]byte, initReplySize)); err != nil {
+					return
+				}
+				io.Copy(io.Discard, conn) //nolint:errcheck // stall
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestHandshakeDeadline(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 200 * time.Millisecond
+
+	connector := NewBasicAuthConnector(stallingServer(t), "user", "password")
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := connector.Connect(ctx)
+	if err == nil {
+		conn.Close()
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v - expected %v", err, context.DeadlineExceeded)
+	}
+	if d := time.Since(start); d > 10*timeout {
+		t.Fatalf("connect took %s - expected about %s", d, timeout)
+	}
+}
+
+func TestHandshakeCancel(t *testing.T) {
+	t.Parallel()
+
+	connector := NewBasicAuthConnector(stallingServer(t), "user", "password")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	conn, err := connector.Connect(ctx)
+	if err == nil {
+		conn.Close()
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v - expected %v", err, context.Canceled)
+	}
+}
@@ -3,6 +3,7 @@ package driver
 import (
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/SAP/go-hdb/driver/internal/rand/alphanum"
 )
@@ -25,3 +26,26 @@ func (i Identifier) String() string {
 	}
 	return strconv.Quote(s)
 }
+
+/*
+EscapeLike escapes the LIKE pattern wildcard characters '%' and '_' as well as the escape character
+itself in s, so that s is matched literally in a LIKE predicate. The escape character needs to be
+provided to the database via the ESCAPE clause:
+
+	pattern := driver.EscapeLike(userInput, '\\') + "%"
+	db.Query("select * from t where name like ? escape '\\'", pattern)
+
+Please note that the result is meant to be used as parameter value and not to be embedded into
+the SQL statement text.
+*/
+func EscapeLike(s string, escape rune) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if r == '%' || r == '_' || r == escape {
+			b.WriteRune(escape)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
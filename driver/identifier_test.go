@@ -29,3 +29,26 @@ func TestIdentifierStringer(t *testing.T) {
 		}
 	}
 }
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		s      string
+		escape rune
+		r      string
+	}{
+		{"", '\\', ""},
+		{"abc", '\\', "abc"},
+		{"100%", '\\', `100\%`},
+		{"a_b", '\\', `a\_b`},
+		{`a\b`, '\\', `a\\b`},
+		{`%_\`, '\\', `\%\_\\`},
+		{"50%_off!", '!', "50!%!_off!!"},
+		{"日本%語", '\\', `日本\%語`},
+	}
+
+	for _, test := range tests {
+		if r := EscapeLike(test.s, test.escape); r != test.r {
+			t.Fatalf("escape %q with %q: %q - expected %q", test.s, test.escape, r, test.r)
+		}
+	}
+}
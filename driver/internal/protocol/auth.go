@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
@@ -11,6 +12,7 @@ import (
 type AuthHnd struct {
 	logonname string
 	methods   auth.Methods
+	preferred []string    // preferred method types (nil: all methods in default order)
 	selected  auth.Method // selected method
 }
 
@@ -35,9 +37,36 @@ func (a *AuthHnd) AddBasic(username, password string) {
 // AddJWT adds JWT authentication method.
 func (a *AuthHnd) AddJWT(token string) { a.methods[auth.MtJWT] = auth.NewJWT(token) }
 
+// AddSAML adds SAML authentication method.
+func (a *AuthHnd) AddSAML(assertion string) { a.methods[auth.MtSAML] = auth.NewSAML(assertion) }
+
 // AddX509 adds X509 authentication method.
 func (a *AuthHnd) AddX509(certKey *auth.CertKey) { a.methods[auth.MtX509] = auth.NewX509(certKey) }
 
+// SetPreferredMethods restricts the authentication methods offered to the database server to the method types mts
+// in the given order of preference.
+func (a *AuthHnd) SetPreferredMethods(mts []string) { a.preferred = mts }
+
+// offered returns the authentication methods offered to the database server.
+func (a *AuthHnd) offered() ([]auth.Method, error) {
+	if a.preferred == nil {
+		return a.methods.Order(), nil
+	}
+	methods := make([]auth.Method, 0, len(a.preferred))
+	for _, mt := range a.preferred {
+		if !auth.IsMethodType(mt) {
+			return nil, fmt.Errorf("invalid preferred method type: %s", mt)
+		}
+		if m, ok := a.methods[mt]; ok {
+			methods = append(methods, m)
+		}
+	}
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("none of the preferred method types %v is available (available: %v)", a.preferred, a.methods.Types())
+	}
+	return methods, nil
+}
+
 // Selected returns the selected authentication method.
 func (a *AuthHnd) Selected() auth.Method { return a.selected }
 
@@ -46,6 +75,9 @@ func (a *AuthHnd) setMethod(mt string) error {
 	if a.selected, ok = a.methods[mt]; !ok {
 		return fmt.Errorf("invalid method type: %s", mt)
 	}
+	if a.preferred != nil && !slices.Contains(a.preferred, mt) {
+		return fmt.Errorf("method type %s not in preferred method types %v", mt, a.preferred)
+	}
 	return nil
 }
 
@@ -53,7 +85,11 @@ func (a *AuthHnd) setMethod(mt string) error {
 func (a *AuthHnd) InitRequest() (*AuthInitRequest, error) {
 	prms := &auth.Prms{}
 	prms.AddCESU8String(a.logonname)
-	for _, m := range a.methods.Order() {
+	methods, err := a.offered()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range methods {
 		if err := m.PrepareInitReq(prms); err != nil {
 			return nil, err
 		}
@@ -15,13 +15,15 @@ import (
 authentication method types supported by the driver:
   - basic authentication (username, password based) (whether SCRAMSHA256 or SCRAMPBKDF2SHA256) and
   - X509 (client certificate) authentication and
-  - JWT (token) authentication
+  - JWT (token) authentication and
+  - SAML (assertion) authentication
 */
 const (
 	MtSCRAMSHA256       = "SCRAMSHA256"       // password
 	MtSCRAMPBKDF2SHA256 = "SCRAMPBKDF2SHA256" // password pbkdf2
 	MtX509              = "X509"              // client certificate
 	MtJWT               = "JWT"               // json web token
+	MtSAML              = "SAML"              // saml assertion
 	MtSessionCookie     = "SessionCookie"     // session cookie
 )
 
@@ -30,6 +32,7 @@ const (
 	MoSessionCookie byte = iota
 	MoX509
 	MoJWT
+	MoSAML
 	MoSCRAMPBKDF2SHA256
 	MoSCRAMSHA256
 )
@@ -45,6 +48,16 @@ type Method interface {
 	FinalRepDecode(d *Decoder) error
 }
 
+// IsMethodType returns true if mt is an authentication method type supported by the driver.
+func IsMethodType(mt string) bool {
+	switch mt {
+	case MtSCRAMSHA256, MtSCRAMPBKDF2SHA256, MtX509, MtJWT, MtSAML, MtSessionCookie:
+		return true
+	default:
+		return false
+	}
+}
+
 // Methods defines a collection of methods.
 type Methods map[string]Method // key equals authentication method type.
 
@@ -58,6 +71,16 @@ func (m Methods) Order() []Method {
 	return methods
 }
 
+// Types returns the method types in method order.
+func (m Methods) Types() []string {
+	methods := m.Order()
+	mts := make([]string, len(methods))
+	for i, method := range methods {
+		mts[i] = method.Typ()
+	}
+	return mts
+}
+
 // CookieGetter is implemented by authentication methods supporting cookies to reconnect.
 type CookieGetter interface {
 	Cookie() (logonname string, cookie []byte)
@@ -67,6 +90,7 @@ var (
 	_ Method = (*SCRAMSHA256)(nil)
 	_ Method = (*SCRAMPBKDF2SHA256)(nil)
 	_ Method = (*JWT)(nil)
+	_ Method = (*SAML)(nil)
 	_ Method = (*X509)(nil)
 	_ Method = (*SessionCookie)(nil)
 )
@@ -5,6 +5,7 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
@@ -17,9 +18,17 @@ import (
 type CertValidationError struct {
 	t    time.Time
 	cert *x509.Certificate
+	err  error // certificate chain error (nil in case of a validity period error)
 }
 
 func (e CertValidationError) Error() string {
+	if e.err != nil {
+		return fmt.Sprintf("certificate issuer %s subject %s invalid certificate chain: %s",
+			e.cert.Issuer.ToRDNSequence().String(),
+			e.cert.Subject.ToRDNSequence().String(),
+			e.err,
+		)
+	}
 	return fmt.Sprintf("certificate issuer %s subject %s not in validity period from %s to %s - now %s",
 		e.cert.Issuer.ToRDNSequence().String(),
 		e.cert.Subject.ToRDNSequence().String(),
@@ -29,6 +38,9 @@ func (e CertValidationError) Error() string {
 	)
 }
 
+// Unwrap returns the nested error.
+func (e CertValidationError) Unwrap() error { return e.err }
+
 // CertKey represents a X509 certificate and key.
 type CertKey struct {
 	cert, key  string // define as string for being immutable
@@ -67,19 +79,54 @@ func (ck *CertKey) Cert() []byte { return []byte(ck.cert) }
 // Key returns the key.
 func (ck *CertKey) Key() []byte { return []byte(ck.key) }
 
-// validate validates the certificate (currently validity period only).
+// validate validates the certificate (validity period and certificate chain).
 func (ck *CertKey) validate(t time.Time) error {
 	t = t.UTC() // cert.NotBefore and cert.NotAfter in UTC as well
-	for _, cert := range ck.certs {
+	for i, cert := range ck.certs {
 		// checks
 		// .check validity period
 		if t.Before(cert.NotBefore) || t.After(cert.NotAfter) {
 			return &CertValidationError{t: t, cert: cert}
 		}
+		// .check that the certificate is signed by the next certificate in the chain
+		if i < len(ck.certs)-1 {
+			if err := cert.CheckSignatureFrom(ck.certs[i+1]); err != nil {
+				return &CertValidationError{t: t, cert: cert, err: err}
+			}
+		}
 	}
 	return nil
 }
 
+// TLSCertificate returns the validated certificate and key as tls.Certificate.
+func (ck *CertKey) TLSCertificate() (*tls.Certificate, error) {
+	if err := ck.validate(time.Now()); err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair([]byte(ck.cert), []byte(ck.key))
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// NewTLSCertKey returns a new certificate and key instance based on a tls.Certificate.
+func NewTLSCertKey(cert tls.Certificate) (*CertKey, error) {
+	if len(cert.Certificate) == 0 {
+		return nil, errors.New("invalid client certificate - certificate chain is empty")
+	}
+	var certPEM []byte
+	for _, der := range cert.Certificate {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	return NewCertKey(certPEM, keyPEM)
+}
+
 // signer returns the cryptographic signer of the key.
 func (ck *CertKey) signer() (crypto.Signer, error) {
 	switch ck.keyBlock.Type {
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"fmt"
+)
+
+// SAML implements SAML authentication.
+type SAML struct {
+	assertion string
+	logonname string
+	_cookie   []byte
+}
+
+// NewSAML creates a new authSAML instance.
+func NewSAML(assertion string) *SAML { return &SAML{assertion: assertion} }
+
+func (a *SAML) String() string {
+	return fmt.Sprintf("method type %s assertion %s", a.Typ(), a.assertion)
+}
+
+// Cookie implements the AuthCookieGetter interface.
+func (a *SAML) Cookie() (string, []byte) { return a.logonname, a._cookie }
+
+// Typ implements the Method interface.
+func (a *SAML) Typ() string { return MtSAML }
+
+// Order implements the Method interface.
+func (a *SAML) Order() byte { return MoSAML }
+
+// PrepareInitReq implements the Method interface.
+func (a *SAML) PrepareInitReq(prms *Prms) error {
+	prms.addString(a.Typ())
+	prms.addString(a.assertion)
+	return nil
+}
+
+// InitRepDecode implements the Method interface.
+func (a *SAML) InitRepDecode(d *Decoder) error {
+	a.logonname = d.String()
+	return nil
+}
+
+// PrepareFinalReq implements the Method interface.
+func (a *SAML) PrepareFinalReq(prms *Prms) error {
+	prms.AddCESU8String(a.logonname)
+	prms.addString(a.Typ())
+	prms.addEmpty() // empty parameter
+	return nil
+}
+
+// FinalRepDecode implements the Method interface.
+func (a *SAML) FinalRepDecode(d *Decoder) error {
+	if err := d.NumPrm(2); err != nil {
+		return err
+	}
+	mt := d.String()
+	if err := checkAuthMethodType(mt, a.Typ()); err != nil {
+		return err
+	}
+	a._cookie = d.bytes()
+	return nil
+}
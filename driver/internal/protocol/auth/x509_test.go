@@ -1,6 +1,14 @@
 package auth
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
@@ -36,12 +44,99 @@ func testX509Validate(t *testing.T) {
 	}
 }
 
+// testCert is a generated certificate and key.
+type testCert struct {
+	cert *x509.Certificate
+	der  []byte
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, name string, notAfter time.Time, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, der: der, key: key}
+}
+
+func testX509ValidateChain(t *testing.T) {
+	valid := time.Now().Add(time.Hour)
+
+	ca := newTestCert(t, "ca", valid, nil)
+	otherCA := newTestCert(t, "otherCA", valid, nil)
+	client := newTestCert(t, "client", valid, ca)
+	expiredClient := newTestCert(t, "expiredClient", time.Now().Add(-time.Minute), ca)
+
+	tests := []struct {
+		name      string
+		cert      *testCert
+		chain     []*testCert
+		chainErr  bool
+		periodErr bool
+	}{
+		{"valid", client, []*testCert{ca}, false, false},
+		{"validLeafOnly", client, nil, false, false},
+		{"invalidChain", client, []*testCert{otherCA}, true, false},
+		{"expired", expiredClient, []*testCert{ca}, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tlsCert := tls.Certificate{Certificate: [][]byte{test.cert.der}, PrivateKey: test.cert.key}
+			for _, cert := range test.chain {
+				tlsCert.Certificate = append(tlsCert.Certificate, cert.der)
+			}
+			certKey, err := NewTLSCertKey(tlsCert)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = certKey.TLSCertificate()
+			if !test.chainErr && !test.periodErr {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var certValidationError *CertValidationError
+			if !errors.As(err, &certValidationError) {
+				t.Fatalf("error %v - expected certificate validation error", err)
+			}
+			if chainErr := errors.Unwrap(certValidationError) != nil; chainErr != test.chainErr {
+				t.Fatalf("chain error %t - expected %t (%s)", chainErr, test.chainErr, err)
+			}
+		})
+	}
+}
+
 func TestX509(t *testing.T) {
 	tests := []struct {
 		name string
 		fct  func(t *testing.T)
 	}{
 		{"testX509Verify", testX509Validate},
+		{"testX509ValidateChain", testX509ValidateChain},
 	}
 
 	for _, test := range tests {
@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/auth"
@@ -100,12 +101,69 @@ func testJWTAuth(t *testing.T) {
 	}
 }
 
+func testPreferredAuthMethods(t *testing.T) {
+	newAuthHnd := func(preferred []string) *AuthHnd {
+		a := NewAuthHnd("")
+		a.AddJWT("dummy token")
+		a.AddBasic("user", "password")
+		a.SetPreferredMethods(preferred)
+		return a
+	}
+
+	offeredTests := []struct {
+		preferred []string
+		offered   []string
+		valid     bool
+	}{
+		{nil, []string{auth.MtJWT, auth.MtSCRAMPBKDF2SHA256, auth.MtSCRAMSHA256}, true}, // default order
+		{[]string{auth.MtSCRAMSHA256, auth.MtSCRAMPBKDF2SHA256}, []string{auth.MtSCRAMSHA256, auth.MtSCRAMPBKDF2SHA256}, true},
+		{[]string{auth.MtX509, auth.MtSCRAMSHA256}, []string{auth.MtSCRAMSHA256}, true}, // X509 not available
+		{[]string{auth.MtX509}, nil, false}, // none available
+		{[]string{"PBKDF2"}, nil, false},    // unknown method type
+	}
+	for _, test := range offeredTests {
+		methods, err := newAuthHnd(test.preferred).offered()
+		if (err == nil) != test.valid {
+			t.Fatalf("preferred %v: got error %v - expected valid %t", test.preferred, err, test.valid)
+		}
+		mts := make([]string, len(methods))
+		for i, m := range methods {
+			mts[i] = m.Typ()
+		}
+		if !slices.Equal(mts, test.offered) {
+			t.Fatalf("preferred %v: offered %v - expected %v", test.preferred, mts, test.offered)
+		}
+	}
+
+	// init request only contains the preferred method
+	a := newAuthHnd([]string{auth.MtJWT})
+	initRequest, err := a.InitRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual, expected := authEncodeStep(t, initRequest), []byte("\x03\x00\x00\x03JWT\x0Bdummy token"); !bytes.Equal(expected, actual) {
+		t.Fatalf("expected %q, got %q", string(expected), string(actual))
+	}
+
+	// server selecting a method not contained in preferred methods
+	a = newAuthHnd([]string{auth.MtSCRAMSHA256})
+	initReply, err := a.InitReply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := encoding.NewDecoder(bytes.NewBuffer([]byte("\x02\x00\x03JWT\x07USER123")), cesu8.DefaultDecoder)
+	if err := initReply.decode(dec); err == nil {
+		t.Fatal("error expected for method not contained in preferred methods")
+	}
+}
+
 func TestAuth(t *testing.T) {
 	tests := []struct {
 		name string
 		fct  func(t *testing.T)
 	}{
 		{"testJWTAuth", testJWTAuth},
+		{"testPreferredAuthMethods", testPreferredAuthMethods},
 	}
 
 	for _, test := range tests {
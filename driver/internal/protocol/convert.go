@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 	hdbreflect "github.com/SAP/go-hdb/driver/internal/reflect"
 	"golang.org/x/text/transform"
 )
@@ -27,6 +28,8 @@ const (
 	maxBigint   = math.MaxInt64
 	maxReal     = math.MaxFloat32
 	maxDouble   = math.MaxFloat64
+	minYear     = 1    // minimal year of hdb date types
+	maxYear     = 9999 // maximal year of hdb date types
 )
 
 var (
@@ -38,9 +41,13 @@ var (
 
 var (
 	errConversionNotSupported = errors.New("conversion not supported")
-	errUint64OutOfRange       = errors.New("uint64 values with high bit set are not supported")
+	errUint64OutOfRange       = errors.New("uint64 values with high bit set are not supported by integer types")
 	errIntegerOutOfRange      = errors.New("integer out of range")
 	errFloatOutOfRange        = errors.New("float out of range")
+	errDateOutOfRange         = errors.New("date out of range")
+	errConversionLossy        = errors.New("lossy conversion not supported")
+	errUnknownTypeCode        = errors.New("unknown type code")
+	errDimensionMismatch      = errors.New("real vector dimension mismatch")
 )
 
 /*
@@ -53,8 +60,6 @@ func convertBool(v any) (any, error) {
 	switch v := v.(type) {
 	case bool:
 		return v, nil
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		return v != 0, nil
 	case string:
 		return strconv.ParseBool(v)
 	}
@@ -89,6 +94,40 @@ var (
 	i64One  = int64(1)
 )
 
+// uint64RangeError returns the error for uint64 values exceeding the int64 range of BIGINT.
+func uint64RangeError(u64 uint64) error {
+	return fmt.Errorf("%w: value %d exceeds %d - please use a DECIMAL field (e.g. DECIMAL(20,0))", errUint64OutOfRange, u64, uint64(math.MaxInt64))
+}
+
+// floatToInteger returns the int64 value of f64 and true if f64 is an integral value within the int64 range.
+// The range check is done explicitly, as the conversion of out of range float values is implementation-specific.
+func floatToInteger(f64 float64) (int64, bool) {
+	if f64 != math.Trunc(f64) || f64 < math.MinInt64 || f64 >= -math.MinInt64 { // NaN and +-INF included
+		return 0, false
+	}
+	return int64(f64), true
+}
+
+// parseInteger parses the integer string s reporting values exceeding the int64 range as integer range error.
+func parseInteger(s string) (int64, error) {
+	i64, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return i64, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%w: value %s exceeds int64 range", errIntegerOutOfRange, s)
+	}
+	return 0, errConversionNotSupported
+}
+
+// checkIntegerRange checks if i64 is in the range of the hdb integer type [min, max].
+func checkIntegerRange(i64, min, max int64) (any, error) {
+	if i64 > max || i64 < min {
+		return nil, fmt.Errorf("%w: value %d not in range [%d, %d]", errIntegerOutOfRange, i64, min, max)
+	}
+	return i64, nil
+}
+
 func convertInteger(v any, min, max int64) (any, error) { //nolint: gocyclo
 	switch v := v.(type) {
 	case bool:
@@ -97,98 +136,50 @@ func convertInteger(v any, min, max int64) (any, error) { //nolint: gocyclo
 		}
 		return i64Zero, nil
 	case int:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case int8:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case int16:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case int32:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case int64:
-		if v > max || v < min {
-			return nil, errIntegerOutOfRange
-		}
-		return v, nil
+		return checkIntegerRange(v, min, max)
 	case uint:
 		u64 := uint64(v)
 		if u64 > math.MaxInt64 {
-			return nil, errUint64OutOfRange
-		}
-		i64 := int64(u64)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
+			return nil, uint64RangeError(u64)
 		}
-		return i64, nil
+		return checkIntegerRange(int64(u64), min, max)
 	case uint8:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case uint16:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case uint32:
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case uint64:
 		if v > math.MaxInt64 {
-			return nil, errUint64OutOfRange
-		}
-		i64 := int64(v)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
+			return nil, uint64RangeError(v)
 		}
-		return i64, nil
+		return checkIntegerRange(int64(v), min, max)
 	case float32:
-		i64 := int64(v)
-		if v != float32(i64) { // should work for overflow, NaN, +-INF as well
-			return nil, errConversionNotSupported
-		}
-		if i64 > max || i64 < min {
+		i64, ok := floatToInteger(float64(v))
+		if !ok {
 			return nil, errConversionNotSupported
 		}
-		return i64, nil
+		return checkIntegerRange(i64, min, max)
 	case float64:
-		i64 := int64(v)
-		if v != float64(i64) { // should work for overflow, NaN, +-INF as well
+		i64, ok := floatToInteger(v)
+		if !ok {
 			return nil, errConversionNotSupported
 		}
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(i64, min, max)
 	case string:
-		i64, err := strconv.ParseInt(v, 10, 64)
+		i64, err := parseInteger(v)
 		if err != nil {
 			return nil, err
 		}
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(i64, min, max)
 	}
 
 	rv := reflect.ValueOf(v)
@@ -199,46 +190,27 @@ func convertInteger(v any, min, max int64) (any, error) { //nolint: gocyclo
 		}
 		return i64Zero, nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		i64 := rv.Int()
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(rv.Int(), min, max)
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
-		i64 := int64(rv.Uint())
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(int64(rv.Uint()), min, max)
 	case reflect.Uint64:
 		u64 := rv.Uint()
 		if u64 > math.MaxInt64 {
-			return nil, errUint64OutOfRange
-		}
-		i64 := int64(u64)
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
+			return nil, uint64RangeError(u64)
 		}
-		return i64, nil
+		return checkIntegerRange(int64(u64), min, max)
 	case reflect.Float32, reflect.Float64:
-		f64 := rv.Float()
-		i64 := int64(f64)
-		if f64 != float64(i64) { // should work for overflow, NaN, +-INF as well
+		i64, ok := floatToInteger(rv.Float())
+		if !ok {
 			return nil, errConversionNotSupported
 		}
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
-		}
-		return i64, nil
+		return checkIntegerRange(i64, min, max)
 	case reflect.String:
-		i64, err := strconv.ParseInt(rv.String(), 10, 64)
+		i64, err := parseInteger(rv.String())
 		if err != nil {
-			return nil, errConversionNotSupported
-		}
-		if i64 > max || i64 < min {
-			return nil, errIntegerOutOfRange
+			return nil, err
 		}
-		return i64, nil
+		return checkIntegerRange(i64, min, max)
 	case reflect.Ptr:
 		if rv.IsNil() {
 			return nil, nil
@@ -414,11 +386,85 @@ func convertTime(v any) (any, error) {
 	}
 }
 
+/*
+convertDate converts v to a time value of a hdb date type.
+Hdb does support dates between 0001-01-01 and 9999-12-31 (UTC) only
+- dates before 1582-10-15 are interpreted as dates of the Julian calendar like in hdb
+- year 0 and BCE dates are not supported
+*/
+func convertDate(v any) (any, error) {
+	v, err := convertTime(v)
+	if err != nil || v == nil {
+		return v, err
+	}
+	if year := v.(time.Time).UTC().Year(); year < minYear || year > maxYear {
+		return nil, errDateOutOfRange
+	}
+	return v, nil
+}
+
 var (
 	ratZero = big.NewRat(0, 1)
 	ratOne  = big.NewRat(1, 1)
 )
 
+// maxDecimalExp is the maximum absolute exponent of a decimal string (exceeding the range of all hdb decimal types).
+const maxDecimalExp = 10000
+
+/*
+parseDecimal parses the decimal string s exactly (without any intermediate floating point conversion).
+Supported is the decimal notation [+-]digits[.digits][(e|E)[+-]digits], where either the integer or the
+fractional digits may be omitted (e.g. "123.45", "-.5", "1e-3"). Other notations accepted by big.Rat
+(fractions, hexadecimal values, underscores) are rejected.
+*/
+func parseDecimal(s string) (*big.Rat, error) {
+	invalidErr := fmt.Errorf("%w: invalid decimal string %q", errConversionNotSupported, s)
+
+	mantissa, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		mantissa = s[:i]
+		es := s[i+1:]
+		if es != "" && (es[0] == '+' || es[0] == '-') {
+			es = es[1:]
+		}
+		if es == "" || strings.IndexFunc(es, isNotDigit) != -1 {
+			return nil, invalidErr
+		}
+		var err error
+		if exp, err = strconv.Atoi(s[i+1:]); err != nil || exp > maxDecimalExp || exp < -maxDecimalExp {
+			return nil, fmt.Errorf("%w: decimal string %q", encoding.ErrDecimalOutOfRange, s)
+		}
+	}
+
+	neg := false
+	if mantissa != "" && (mantissa[0] == '+' || mantissa[0] == '-') {
+		neg = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && fracPart == "" || strings.IndexFunc(intPart, isNotDigit) != -1 || strings.IndexFunc(fracPart, isNotDigit) != -1 {
+		return nil, invalidErr
+	}
+
+	m, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return nil, invalidErr
+	}
+	if neg {
+		m.Neg(m)
+	}
+	exp -= len(fracPart)
+
+	if exp >= 0 {
+		return new(big.Rat).SetInt(m.Mul(m, pow10(exp))), nil
+	}
+	return new(big.Rat).SetFrac(m, pow10(-exp)), nil
+}
+
+func pow10(n int) *big.Int { return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil) }
+
+func isNotDigit(r rune) bool { return r < '0' || r > '9' }
+
 /*
 Currently the min, max check is done during encoding, as the check is expensive and
 we want to avoid doing the conversion twice (convert + encode).
@@ -467,17 +513,15 @@ func convertDecimal(v any) (any, error) { //nolint: gocyclo
 		if r == nil {
 			return nil, errConversionNotSupported
 		}
+		return r, nil
 	case float64:
 		r := new(big.Rat).SetFloat64(v)
 		if r == nil {
 			return nil, errConversionNotSupported
 		}
-	case string:
-		r, ok := new(big.Rat).SetString(v)
-		if !ok {
-			return nil, errConversionNotSupported
-		}
 		return r, nil
+	case string:
+		return parseDecimal(v)
 	}
 
 	rv := reflect.ValueOf(v)
@@ -498,11 +542,7 @@ func convertDecimal(v any) (any, error) { //nolint: gocyclo
 		}
 		return r, nil
 	case reflect.String:
-		r, ok := new(big.Rat).SetString(rv.String())
-		if !ok {
-			return nil, errConversionNotSupported
-		}
-		return r, nil
+		return parseDecimal(rv.String())
 	case reflect.Ptr:
 		if rv.IsNil() {
 			return nil, nil
@@ -517,6 +557,96 @@ func convertDecimal(v any) (any, error) { //nolint: gocyclo
 	}
 }
 
+// floatingDecimalScale is the scale reported for floating point decimal fields (DECIMAL without precision, SMALLDECIMAL).
+const floatingDecimalScale = math.MaxInt16
+
+// isFloat returns true if v is a (pointer to a) floating point value. As the exact binary value of a float is
+// rarely representable in decimal scale (e.g. 0.1), float values are always rounded to the scale of a decimal field.
+func isFloat(v any) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	return rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64
+}
+
+/*
+checkDecimal checks if r can be represented within the precision prec and scale of a decimal field.
+Floating point decimal fields are limited to prec significant digits.
+In case round is set, r is rounded half-to-even to the scale (significant digits) of the field,
+otherwise a value exceeding the scale is rejected. A value exceeding the precision is rejected in any case.
+*/
+func checkDecimal(r *big.Rat, prec, scale int, round bool) (*big.Rat, error) {
+	if r.Sign() == 0 || prec <= 0 {
+		return r, nil
+	}
+	floating := scale == floatingDecimalScale
+	if floating {
+		scale = prec - 1 - decimalExp(r)
+	}
+
+	m, exact := scaleRat(r, scale, round)
+	if !exact && !round {
+		if floating {
+			return nil, fmt.Errorf("%w: value %s exceeds %d significant digits", errConversionLossy, r.RatString(), prec)
+		}
+		return nil, fmt.Errorf("%w: value %s exceeds scale %d", errConversionLossy, r.RatString(), scale)
+	}
+	if !floating && m.CmpAbs(pow10(prec)) >= 0 {
+		return nil, fmt.Errorf("%w: value %s exceeds precision %d with scale %d", encoding.ErrDecimalOutOfRange, r.RatString(), prec, scale)
+	}
+	if exact {
+		return r, nil
+	}
+	if scale >= 0 {
+		return new(big.Rat).SetFrac(m, pow10(scale)), nil
+	}
+	return new(big.Rat).SetInt(m.Mul(m, pow10(-scale))), nil
+}
+
+// decimalExp returns the exponent of the most significant decimal digit of r (r != 0).
+func decimalExp(r *big.Rat) int {
+	a := new(big.Int).Abs(r.Num())
+	b := r.Denom()
+	exp := len(a.String()) - len(b.String())
+	// a / b < 10^exp -> decrement
+	if exp >= 0 {
+		if a.Cmp(new(big.Int).Mul(b, pow10(exp))) < 0 {
+			exp--
+		}
+	} else if new(big.Int).Mul(a, pow10(-exp)).Cmp(b) < 0 {
+		exp--
+	}
+	return exp
+}
+
+// scaleRat returns m = r * 10^scale and true if m is integral. Otherwise m is truncated or, if round is set,
+// rounded half-to-even.
+func scaleRat(r *big.Rat, scale int, round bool) (*big.Int, bool) {
+	a := new(big.Int).Set(r.Num())
+	b := new(big.Int).Set(r.Denom())
+	if scale >= 0 {
+		a.Mul(a, pow10(scale))
+	} else {
+		b.Mul(b, pow10(-scale))
+	}
+	m, rest := a.QuoRem(a, b, new(big.Int))
+	if rest.Sign() == 0 {
+		return m, true
+	}
+	if round {
+		// half-to-even (rest has the sign of the numerator)
+		if c := rest.Add(rest, rest).CmpAbs(b); c > 0 || (c == 0 && m.Bit(0) == 1) {
+			if rest.Sign() < 0 {
+				m.Sub(m, big.NewInt(1))
+			} else {
+				m.Add(m, big.NewInt(1))
+			}
+		}
+	}
+	return m, false
+}
+
 func convertBytes(v any) (any, error) {
 	switch v := v.(type) {
 	case string, []byte:
@@ -546,6 +676,52 @@ func convertBytes(v any) (any, error) {
 	}
 }
 
+var float32SliceReflectType = hdbreflect.TypeFor[[]float32]()
+
+/*
+convertRealVector converts v to a []float32 real vector:
+  - []float32 values (and types convertible to []float32) are used as is
+  - []float64 values are converted element-wise, where values exceeding the real range are rejected
+  - nil slices are converted to a NULL value
+*/
+func convertRealVector(v any) (any, error) {
+	switch v := v.(type) {
+	case []float32:
+		if v == nil {
+			return nil, nil
+		}
+		return v, nil
+	case []float64:
+		if v == nil {
+			return nil, nil
+		}
+		fv := make([]float32, len(v))
+		for i, f := range v {
+			if math.Abs(f) > maxReal {
+				return nil, fmt.Errorf("%w: element %d value %g", errFloatOutOfRange, i, f)
+			}
+			fv[i] = float32(f)
+		}
+		return fv, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return convertRealVector(rv.Elem().Interface())
+	case reflect.Slice:
+		if rv.Type().ConvertibleTo(float32SliceReflectType) {
+			return convertRealVector(rv.Convert(float32SliceReflectType).Interface())
+		}
+		return nil, errConversionNotSupported
+	default:
+		return nil, errConversionNotSupported
+	}
+}
+
 // readProvider is the interface wrapping the Reader which provides an io.Reader.
 type readProvider interface {
 	Reader() io.Reader
@@ -563,7 +739,14 @@ func convertLob(v any, t transform.Transformer) (any, error) {
 	case io.Reader:
 		return convertToLobInDescr(t, v), nil
 	case readProvider:
-		return convertToLobInDescr(t, v.Reader()), nil
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil, nil
+		}
+		rd := v.Reader()
+		if rd == nil { // no reader: empty (zero-length) lob
+			rd = bytes.NewReader(nil)
+		}
+		return convertToLobInDescr(t, rd), nil
 	default:
 		// check if string or []byte
 		if v, err := convertBytes(v); err == nil {
@@ -588,6 +771,51 @@ func convertLob(v any, t transform.Transformer) (any, error) {
 	}
 }
 
+/*
+coerceField converts v losslessly to the numeric hdb type of type code tc using a decimal intermediate value,
+so that compatible types are accepted which are not supported by convertField (e.g. *big.Rat or "42.0" to integer types).
+Conversions loosing precision (e.g. 1.5 to integer types) are rejected.
+*/
+func coerceField(tc typeCode, v any) (any, error) {
+	switch tc {
+	case tcTinyint, tcSmallint, tcInteger, tcBigint, tcReal, tcDouble:
+	default:
+		return nil, errConversionNotSupported
+	}
+
+	cv, err := convertDecimal(v)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := cv.(*big.Rat)
+	if !ok { // nil or rat value (custom type)
+		return nil, errConversionNotSupported
+	}
+
+	switch tc {
+	case tcReal:
+		f32, exact := r.Float32()
+		if !exact {
+			return nil, fmt.Errorf("%w: value %s", errConversionLossy, r.RatString())
+		}
+		return convertField(tc, f32, nil)
+	case tcDouble:
+		f64, exact := r.Float64()
+		if !exact {
+			return nil, fmt.Errorf("%w: value %s", errConversionLossy, r.RatString())
+		}
+		return convertField(tc, f64, nil)
+	default: // integer types
+		if !r.IsInt() {
+			return nil, fmt.Errorf("%w: value %s", errConversionLossy, r.RatString())
+		}
+		if !r.Num().IsInt64() {
+			return nil, fmt.Errorf("%w: value %s", errIntegerOutOfRange, r.RatString())
+		}
+		return convertField(tc, r.Num().Int64(), nil)
+	}
+}
+
 func convertField(tc typeCode, v any, t transform.Transformer) (any, error) {
 	if v == nil {
 		return nil, nil
@@ -608,7 +836,9 @@ func convertField(tc typeCode, v any, t transform.Transformer) (any, error) {
 		return convertFloat(v, maxReal)
 	case tcDouble:
 		return convertFloat(v, maxDouble)
-	case tcDate, tcTime, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate, tcSecondtime:
+	case tcDate, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate:
+		return convertDate(v)
+	case tcTime, tcSecondtime:
 		return convertTime(v)
 	case tcDecimal, tcFixed8, tcFixed12, tcFixed16:
 		return convertDecimal(v)
@@ -620,7 +850,93 @@ func convertField(tc typeCode, v any, t transform.Transformer) (any, error) {
 		return convertLob(v, t)
 	case tcBintext: // ?? lobCESU8Type
 		return convertLob(v, nil)
+	case tcRealVector:
+		return convertRealVector(v)
+	default:
+		return nil, fmt.Errorf("%w %s", errUnknownTypeCode, tc)
+	}
+}
+
+// integerRange returns the value range of the hdb integer type tc.
+func integerRange(tc typeCode) (int64, int64, bool) {
+	switch tc {
+	case tcTinyint:
+		return minTinyint, maxTinyint, true
+	case tcSmallint:
+		return minSmallint, maxSmallint, true
+	case tcInteger:
+		return minInteger, maxInteger, true
+	case tcBigint:
+		return minBigint, maxBigint, true
 	default:
-		panic(fmt.Sprintf("invalid type code %s", tc))
+		return 0, 0, false
+	}
+}
+
+func convertColumnValues[T any](column []T, fn func(v T) (any, error)) ([]any, int, bool, error) {
+	values := make([]any, len(column))
+	for i, v := range column {
+		var err error
+		if values[i], err = fn(v); err != nil {
+			return nil, i, true, err
+		}
+	}
+	return values, 0, true, nil
+}
+
+func identityValue[T any](v T) (any, error) { return v, nil }
+
+/*
+convertColumn converts all values of column at once, resolving the conversion
+once per column instead of once per value for the most common column types.
+It returns false if the column type is not supported for the hdb type tc and
+the row of the failing value in case of an error.
+*/
+func convertColumn(tc typeCode, column any) ([]any, int, bool, error) { //nolint: gocyclo
+	switch column := column.(type) {
+	case []int:
+		if min, max, ok := integerRange(tc); ok {
+			return convertColumnValues(column, func(v int) (any, error) { return checkIntegerRange(int64(v), min, max) })
+		}
+	case []int32:
+		if min, max, ok := integerRange(tc); ok {
+			return convertColumnValues(column, func(v int32) (any, error) { return checkIntegerRange(int64(v), min, max) })
+		}
+	case []int64:
+		if min, max, ok := integerRange(tc); ok {
+			return convertColumnValues(column, func(v int64) (any, error) { return checkIntegerRange(v, min, max) })
+		}
+	case []float64:
+		switch tc {
+		case tcReal:
+			return convertColumnValues(column, func(v float64) (any, error) { return convertFloat(v, maxReal) })
+		case tcDouble:
+			return convertColumnValues(column, identityValue[float64])
+		}
+	case []bool:
+		switch tc {
+		case tcBoolean:
+			return convertColumnValues(column, identityValue[bool])
+		case tcTinyint, tcSmallint, tcInteger, tcBigint: // e.g. legacy boolean representation (TINYINT)
+			return convertColumnValues(column, func(v bool) (any, error) { return convertInteger(v, 0, 1) })
+		}
+	case []string:
+		switch tc {
+		case tcChar, tcVarchar, tcString, tcAlphanum, tcNchar, tcNvarchar, tcNstring, tcShorttext, tcBinary, tcVarbinary, tcStPoint, tcStGeometry:
+			return convertColumnValues(column, identityValue[string])
+		}
+	case []time.Time:
+		switch tc {
+		case tcDate, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate:
+			return convertColumnValues(column, func(v time.Time) (any, error) {
+				if year := v.UTC().Year(); year < minYear || year > maxYear {
+					return nil, errDateOutOfRange
+				}
+				return v, nil
+			})
+		case tcTime, tcSecondtime:
+			return convertColumnValues(column, identityValue[time.Time])
+		}
 	}
+	return nil, 0, false, nil
 }
@@ -3,10 +3,17 @@ package protocol
 import (
 	"bytes"
 	"errors"
+	"io"
 	"math"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
 )
 
 func assertEqualInt(t *testing.T, tc typeCode, v any, r int64) { //nolint:unparam
@@ -64,6 +71,43 @@ func testConvertInteger(t *testing.T) {
 
 	// integer as string
 	assertEqualInt(t, tcInteger, "42", 42)
+
+	// boundaries
+	boundaries := []struct {
+		tc       typeCode
+		min, max int64
+	}{
+		{tcTinyint, minTinyint, maxTinyint},
+		{tcSmallint, minSmallint, maxSmallint},
+		{tcInteger, minInteger, maxInteger},
+		{tcBigint, minBigint, maxBigint},
+	}
+	for _, b := range boundaries {
+		assertEqualInt(t, b.tc, b.min, b.min)
+		assertEqualInt(t, b.tc, b.max, b.max)
+		assertEqualInt(t, b.tc, strconv.FormatInt(b.max, 10), b.max)
+		assertEqualInt(t, b.tc, testCustomInt(b.max), b.max)
+		if b.min != minBigint {
+			assertEqualIntOutOfRangeError(t, b.tc, b.min-1)
+			assertEqualIntOutOfRangeError(t, b.tc, float64(b.min-1))
+			assertEqualIntOutOfRangeError(t, b.tc, testCustomInt(b.min-1))
+		}
+		if b.max != maxBigint {
+			assertEqualIntOutOfRangeError(t, b.tc, b.max+1)
+			assertEqualIntOutOfRangeError(t, b.tc, uint64(b.max+1))
+			assertEqualIntOutOfRangeError(t, b.tc, float32(b.max+1))
+			assertEqualIntOutOfRangeError(t, b.tc, strconv.FormatInt(b.max+1, 10))
+		}
+	}
+	// tinyint is unsigned
+	assertEqualIntOutOfRangeError(t, tcTinyint, int8(-1))
+	assertEqualInt(t, tcTinyint, uint8(math.MaxUint8), math.MaxUint8)
+	assertEqualInt(t, tcSmallint, int8(math.MinInt8), math.MinInt8)
+
+	// error message contains value and range
+	if _, err := convertField(tcTinyint, 300, nil); err == nil || err.Error() != "integer out of range: value 300 not in range [0, 255]" {
+		t.Fatalf("invalid error %v", err)
+	}
 }
 
 func assertEqualFloat(t *testing.T, tc typeCode, v any, r float64) {
@@ -125,6 +169,14 @@ func assertEqualTime(t *testing.T, tc typeCode, v any, r time.Time) {
 	}
 }
 
+func assertEqualDateOutOfRangeError(t *testing.T, tc typeCode, v any) {
+	_, err := convertField(tc, v, nil)
+
+	if !errors.Is(err, errDateOutOfRange) {
+		t.Fatalf("assert equal out of range error failed %s %v", tc, v)
+	}
+}
+
 func testConvertTime(t *testing.T) {
 	type testCustomTime time.Time
 
@@ -138,6 +190,23 @@ func testConvertTime(t *testing.T) {
 
 	// time reference
 	assertEqualTime(t, tcTimestamp, &timeValue, timeValue)
+
+	// date range
+	minDate := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxDate := time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC)
+	for _, tc := range []typeCode{tcDate, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate} {
+		assertEqualTime(t, tc, minDate, minDate)
+		assertEqualTime(t, tc, maxDate, maxDate)
+		assertEqualDateOutOfRangeError(t, tc, minDate.Add(-time.Nanosecond))
+		assertEqualDateOutOfRangeError(t, tc, maxDate.Add(time.Nanosecond))
+		assertEqualDateOutOfRangeError(t, tc, time.Date(-100, time.March, 1, 0, 0, 0, 0, time.UTC)) // BCE
+		// year 1 in a time zone east of UTC is year 0 in UTC
+		assertEqualDateOutOfRangeError(t, tc, time.Date(1, time.January, 1, 0, 0, 0, 0, time.FixedZone("UTC+1", 3600)))
+	}
+	// date components are not relevant for time types
+	for _, tc := range []typeCode{tcTime, tcSecondtime} {
+		assertEqualTime(t, tc, time.Time{}.Add(-time.Hour), time.Time{}.Add(-time.Hour))
+	}
 }
 
 func assertEqualString(t *testing.T, tc typeCode, v any, r string) {
@@ -193,6 +262,561 @@ func testConvertBytes(t *testing.T) {
 	assertEqualBytes(t, tcBinary, &bytesValue, bytesValue)
 }
 
+func testConvertRealVector(t *testing.T) {
+	type testCustomVector []float32
+
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+	f := &ParameterField{names: names, tc: tcRealVector, prec: 3, mode: pmIn}
+
+	tests := []struct {
+		v any
+		r any
+	}{
+		{[]float32{1, 2, 3}, []float32{1, 2, 3}},
+		{[]float64{1.5, -2, 3}, []float32{1.5, -2, 3}},
+		{testCustomVector{4, 5, 6}, []float32{4, 5, 6}},
+		{&[]float32{7, 8, 9}, []float32{7, 8, 9}},
+		{[]float32(nil), nil}, // NULL
+		{(*[]float32)(nil), nil},
+	}
+	for _, test := range tests {
+		cv, err := f.Convert(test.v, nil, ConvertOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(cv, test.r) {
+			t.Fatalf("%v: got %v - expected %v", test.v, cv, test.r)
+		}
+	}
+
+	errTests := []struct {
+		v   any
+		err error
+	}{
+		{[]float32{1, 2}, errDimensionMismatch},
+		{[]float64{1, 2, 3, 4}, errDimensionMismatch},
+		{[]float64{1, math.MaxFloat64, 3}, errFloatOutOfRange},
+		{[]int{1, 2, 3}, errConversionNotSupported},
+	}
+	for _, test := range errTests {
+		if _, err := f.Convert(test.v, nil, ConvertOptions{}); !errors.Is(err, test.err) {
+			t.Fatalf("%v: got error %v - expected %v", test.v, err, test.err)
+		}
+	}
+
+	// field without dimension
+	f = &ParameterField{names: names, tc: tcRealVector, mode: pmIn}
+	if _, err := f.Convert([]float32{1, 2}, nil, ConvertOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testConvertDecimalString(t *testing.T) {
+	type testCustomString string
+
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+	f := &ParameterField{names: names, tc: tcFixed8, prec: 10, scale: 2, mode: pmIn}
+
+	tests := []struct {
+		v     any
+		m     int64 // fixed significand (scale 2)
+		round bool
+	}{
+		{"123.45", 12345, false},
+		{"-123.45", -12345, false},
+		{"+0.1", 10, false},
+		{".5", 50, false},
+		{"7.", 700, false},
+		{"1e2", 10000, false},
+		{"12345E-2", 12345, false},
+		{"0.10000000000000000000000000001", 10, true}, // exact - no float rounding
+		{"1.235", 124, true},                          // rounding (half-to-even)
+		{"-1.235", -124, true},
+		{"1.2349999999999999999", 123, true},
+		{testCustomString("99999999.99"), 9999999999, false},
+	}
+	for _, test := range tests {
+		cv, err := f.Convert(test.v, nil, ConvertOptions{RoundDecimal: test.round})
+		if err != nil {
+			t.Fatalf("%v: %s", test.v, err)
+		}
+		buf := new(bytes.Buffer)
+		enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder)
+		if err := enc.Fixed8Field(cv, f.prec, f.scale); err != nil {
+			t.Fatalf("%v: %s", test.v, err)
+		}
+		dec := encoding.NewDecoder(buf, cesu8.DefaultDecoder)
+		if m := dec.Fixed(encoding.Fixed8FieldSize); m.Cmp(big.NewInt(test.m)) != 0 {
+			t.Fatalf("%v: got %s - expected %d", test.v, m, test.m)
+		}
+	}
+
+	// exceeding precision
+	for _, v := range []string{"100000000", "99999999.995", "-1e8"} {
+		if _, err := f.Convert(v, nil, ConvertOptions{RoundDecimal: true}); !errors.Is(err, encoding.ErrDecimalOutOfRange) {
+			t.Fatalf("%v: got error %v - expected %v", v, err, encoding.ErrDecimalOutOfRange)
+		}
+	}
+
+	// malformed strings
+	for _, v := range []string{"", "-", ".", "1.2.3", "1/3", "0x10", "1_000", "1e", "1e+", "1e1.5", " 1", "1,5", "NaN", "Inf"} {
+		if _, err := f.Convert(v, nil, ConvertOptions{}); !errors.Is(err, errConversionNotSupported) {
+			t.Fatalf("%q: got error %v - expected %v", v, err, errConversionNotSupported)
+		}
+	}
+	// exponent out of range
+	if _, err := f.Convert("1e100000", nil, ConvertOptions{}); !errors.Is(err, encoding.ErrDecimalOutOfRange) {
+		t.Fatalf("got error %v - expected %v", err, encoding.ErrDecimalOutOfRange)
+	}
+}
+
+func testConvertDecimalScale(t *testing.T) {
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+
+	max38 := strings.Repeat("9", 38)
+
+	tests := []struct {
+		tc          typeCode
+		prec, scale int
+		v           string
+		strict      error  // expected error without rounding (nil: exact)
+		rounded     string // expected value with rounding (empty: error expected)
+	}{
+		// DECIMAL(38,0) boundaries
+		{tcFixed16, 38, 0, max38, nil, max38},
+		{tcFixed16, 38, 0, "-" + max38, nil, "-" + max38},
+		{tcFixed16, 38, 0, "1" + strings.Repeat("0", 38), encoding.ErrDecimalOutOfRange, ""},
+		{tcFixed16, 38, 0, max38 + ".4", errConversionLossy, max38},
+		{tcFixed16, 38, 0, max38 + ".5", errConversionLossy, ""}, // rounding exceeds precision
+		// DECIMAL(38,38) boundaries
+		{tcFixed16, 38, 38, "0." + max38, nil, "0." + max38},
+		{tcFixed16, 38, 38, "-0." + max38, nil, "-0." + max38},
+		{tcFixed16, 38, 38, "1", encoding.ErrDecimalOutOfRange, ""},
+		{tcFixed16, 38, 38, "0." + max38 + "5", errConversionLossy, ""},
+		{tcFixed16, 38, 38, "0." + strings.Repeat("0", 37) + "15", errConversionLossy, "0." + strings.Repeat("0", 37) + "2"},
+		// DECIMAL(10,2) half-to-even
+		{tcFixed8, 10, 2, "1.225", errConversionLossy, "1.22"},
+		{tcFixed8, 10, 2, "1.235", errConversionLossy, "1.24"},
+		{tcFixed8, 10, 2, "-1.225", errConversionLossy, "-1.22"},
+		{tcFixed8, 10, 2, "-1.2251", errConversionLossy, "-1.23"},
+		{tcFixed8, 10, 2, "0.001", errConversionLossy, "0"},
+		// DECIMAL(p,s) with data format version < 8
+		{tcDecimal, 5, 2, "123.45", nil, "123.45"},
+		{tcDecimal, 5, 2, "123.455", errConversionLossy, "123.46"},
+		{tcDecimal, 5, 2, "1234.5", encoding.ErrDecimalOutOfRange, ""},
+		// SMALLDECIMAL (16 significant digits)
+		{tcDecimal, 16, floatingDecimalScale, "1234567890123456", nil, "1234567890123456"},
+		{tcDecimal, 16, floatingDecimalScale, "0.000001234567890123456", nil, "0.000001234567890123456"},
+		{tcDecimal, 16, floatingDecimalScale, "1e100", nil, "1e100"},
+		{tcDecimal, 16, floatingDecimalScale, "12345678901234565", errConversionLossy, "12345678901234560"},
+		{tcDecimal, 16, floatingDecimalScale, "99999999999999995", errConversionLossy, "1e17"},
+		{tcDecimal, 16, floatingDecimalScale, "-1.0000000000000005", errConversionLossy, "-1"},
+		// floating DECIMAL (34 significant digits)
+		{tcDecimal, 34, floatingDecimalScale, max38, errConversionLossy, "1e38"},
+	}
+	for _, test := range tests {
+		f := &ParameterField{names: names, tc: test.tc, prec: test.prec, scale: test.scale, mode: pmIn}
+
+		check := func(round bool, expErr error, exp string) {
+			cv, err := f.Convert(test.v, nil, ConvertOptions{RoundDecimal: round})
+			switch {
+			case expErr != nil:
+				if !errors.Is(err, expErr) {
+					t.Fatalf("%s(%d,%d) %s round %t: got error %v - expected %v", test.tc, test.prec, test.scale, test.v, round, err, expErr)
+				}
+			case err != nil:
+				t.Fatalf("%s(%d,%d) %s round %t: %s", test.tc, test.prec, test.scale, test.v, round, err)
+			default:
+				expRat, _ := new(big.Rat).SetString(exp)
+				if cv.(*big.Rat).Cmp(expRat) != 0 {
+					t.Fatalf("%s(%d,%d) %s round %t: got %s - expected %s", test.tc, test.prec, test.scale, test.v, round, cv.(*big.Rat).RatString(), expRat.RatString())
+				}
+			}
+		}
+
+		strictExp := test.v
+		if test.strict != nil {
+			strictExp = ""
+		}
+		check(false, test.strict, strictExp)
+
+		var roundErr error
+		if test.rounded == "" {
+			roundErr = encoding.ErrDecimalOutOfRange
+		}
+		check(true, roundErr, test.rounded)
+	}
+
+	// the value of the caller is not modified by rounding
+	r := big.NewRat(1235, 1000)
+	f := &ParameterField{names: names, tc: tcFixed8, prec: 10, scale: 2, mode: pmIn}
+	if _, err := f.Convert(r, nil, ConvertOptions{RoundDecimal: true}); err != nil {
+		t.Fatal(err)
+	}
+	if r.Cmp(big.NewRat(1235, 1000)) != 0 {
+		t.Fatalf("value modified to %s", r.RatString())
+	}
+
+	// floating point values are rounded to the field scale without rounding option
+	f64 := 0.1
+	for _, v := range []any{f64, float32(f64), &f64} {
+		cv, err := f.Convert(v, nil, ConvertOptions{})
+		if err != nil {
+			t.Fatalf("%T %v: %s", v, v, err)
+		}
+		if cv.(*big.Rat).Cmp(big.NewRat(1, 10)) != 0 {
+			t.Fatalf("%T %v: got %s - expected 1/10", v, v, cv.(*big.Rat).RatString())
+		}
+	}
+	if _, err := f.Convert(1e10, nil, ConvertOptions{}); !errors.Is(err, encoding.ErrDecimalOutOfRange) { // precision is checked
+		t.Fatalf("got error %v - expected %v", err, encoding.ErrDecimalOutOfRange)
+	}
+}
+
+type testReadProvider struct{ rd io.Reader }
+
+func (p *testReadProvider) Reader() io.Reader { return p.rd }
+
+func testConvertLob(t *testing.T) {
+	// nil reference -> NULL
+	cv, err := convertField(tcBlob, (*testReadProvider)(nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cv != nil {
+		t.Fatalf("got %v - expected nil", cv)
+	}
+
+	// empty lobs: no reader, empty reader, empty bytes
+	for _, v := range []any{&testReadProvider{}, &testReadProvider{rd: bytes.NewReader(nil)}, []byte{}, ""} {
+		cv, err := convertField(tcBlob, v, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		descr, ok := cv.(*LobInDescr)
+		if !ok {
+			t.Fatalf("got %T - expected %T", cv, descr)
+		}
+		if err := descr.FetchNext(128); err != nil {
+			t.Fatal(err)
+		}
+		if descr.size() != 0 || !descr.Opt.IsLastData() {
+			t.Fatalf("got size %d options %s - expected empty last data", descr.size(), descr.Opt)
+		}
+	}
+}
+
+func testConvertIntegerBoundaries(t *testing.T) {
+	type testUint64 uint64
+	type testString string
+
+	validTests := []struct {
+		v any
+		r int64
+	}{
+		{int64(math.MaxInt64), math.MaxInt64},
+		{int64(math.MinInt64), math.MinInt64},
+		{uint64(math.MaxInt64), math.MaxInt64},
+		{uint(math.MaxInt64), math.MaxInt64},
+		{testUint64(math.MaxInt64), math.MaxInt64},
+		{"9223372036854775807", math.MaxInt64},
+		{"-9223372036854775808", math.MinInt64},
+		{testString("9223372036854775807"), math.MaxInt64},
+		{float64(-(1 << 63)), math.MinInt64},
+		{float64(1 << 62), 1 << 62},
+	}
+	for _, test := range validTests {
+		cv, err := convertField(tcBigint, test.v, nil)
+		if err != nil {
+			t.Fatalf("%T %[1]v: %s", test.v, err)
+		}
+		if cv != test.r {
+			t.Fatalf("%T %[1]v: got %v - expected %d", test.v, cv, test.r)
+		}
+	}
+
+	invalidTests := []struct {
+		v   any
+		err error
+	}{
+		{uint64(math.MaxInt64 + 1), errUint64OutOfRange},
+		{uint64(math.MaxUint64), errUint64OutOfRange},
+		{uint(math.MaxUint64), errUint64OutOfRange},
+		{testUint64(math.MaxUint64), errUint64OutOfRange},
+		{"9223372036854775808", errIntegerOutOfRange},
+		{"-9223372036854775809", errIntegerOutOfRange},
+		{"18446744073709551615", errIntegerOutOfRange},
+		{testString("18446744073709551615"), errIntegerOutOfRange},
+		{float64(1 << 63), errConversionNotSupported}, // exceeds int64 (no implementation-specific saturation)
+		{float32(1 << 63), errConversionNotSupported},
+		{math.Inf(1), errConversionNotSupported},
+		{math.NaN(), errConversionNotSupported},
+		{"abc", errConversionNotSupported},
+	}
+	for _, test := range invalidTests {
+		if _, err := convertField(tcBigint, test.v, nil); !errors.Is(err, test.err) {
+			t.Fatalf("%T %[1]v: got error %v - expected %v", test.v, err, test.err)
+		}
+	}
+
+	// uint64 values exceeding int64 are supported by decimal fields
+	cv, err := convertField(tcFixed16, uint64(math.MaxUint64), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cv.(*big.Rat).Cmp(new(big.Rat).SetUint64(math.MaxUint64)) != 0 {
+		t.Fatalf("got %s - expected %d", cv.(*big.Rat).RatString(), uint64(math.MaxUint64))
+	}
+}
+
+func testConvertLenient(t *testing.T) {
+	// lossless conversions
+	losslessTests := []struct {
+		tc     typeCode
+		v      any
+		r      any
+		strict bool // supported by standard conversion
+	}{
+		{tcInteger, big.NewRat(42, 1), int64(42), false},
+		{tcInteger, big.NewInt(-42), int64(-42), false},
+		{tcTinyint, "42.0", int64(42), false},
+		{tcBigint, float64(1e15), int64(1e15), true},
+		{tcDouble, big.NewRat(3, 2), float64(1.5), false},
+		{tcDouble, "0.25", float64(0.25), true},
+		{tcReal, big.NewRat(1, 4), float64(0.25), false},
+	}
+	for _, test := range losslessTests {
+		if _, err := convertField(test.tc, test.v, nil); (err == nil) != test.strict {
+			t.Fatalf("%s %v: got strict conversion error %v - expected supported %t", test.tc, test.v, err, test.strict)
+		}
+		cv, err := coerceField(test.tc, test.v)
+		if err != nil {
+			t.Fatalf("%s %v: %s", test.tc, test.v, err)
+		}
+		if cv != test.r {
+			t.Fatalf("%s %v: got %v %[3]T - expected %v %[4]T", test.tc, test.v, cv, test.r)
+		}
+	}
+
+	// lossy conversions
+	lossyTests := []struct {
+		tc  typeCode
+		v   any
+		err error
+	}{
+		{tcInteger, float64(1.5), errConversionLossy},
+		{tcInteger, big.NewRat(3, 2), errConversionLossy},
+		{tcInteger, "42.5", errConversionLossy},
+		{tcReal, big.NewRat(1, 3), errConversionLossy},
+		{tcDouble, big.NewRat(1, 3), errConversionLossy},
+		{tcTinyint, big.NewRat(256, 1), errIntegerOutOfRange},
+		{tcBigint, new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(1)), errIntegerOutOfRange},
+		{tcInteger, "abc", errConversionNotSupported},
+		{tcVarchar, big.NewRat(1, 1), errConversionNotSupported},
+	}
+	for _, test := range lossyTests {
+		if _, err := coerceField(test.tc, test.v); !errors.Is(err, test.err) {
+			t.Fatalf("%s %v: got error %v - expected %v", test.tc, test.v, err, test.err)
+		}
+	}
+}
+
+func testConvertError(t *testing.T) {
+	const tcUnknown typeCode = 0x62 // simulated type code unknown to the driver
+
+	tests := []struct {
+		tc  typeCode
+		v   any
+		err error
+		msg string
+	}{
+		{tcInteger, []string{"a"}, errConversionNotSupported, "field F: cannot convert Go type []string value [a] to HANA type INTEGER"},
+		{tcBoolean, time.Time{}, errConversionNotSupported, "field F: cannot convert Go type time.Time value"},
+		{tcDate, 42, errConversionNotSupported, "field F: cannot convert Go type int value 42 to HANA type DATE"},
+		{tcDecimal, []int{1}, errConversionNotSupported, "field F: cannot convert Go type []int value [1] to HANA type DECIMAL"},
+		{tcVarbinary, 1.5, errConversionNotSupported, "field F: cannot convert Go type float64 value 1.5 to HANA type VARBINARY"},
+		{tcTinyint, 256, errIntegerOutOfRange, "field F: cannot convert Go type int value 256 to HANA type TINYINT"},
+		{tcUnknown, 1, errUnknownTypeCode, "field F: cannot convert Go type int value 1 to HANA type UNKNOWN(98)"},
+	}
+
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+
+	for _, test := range tests {
+		f := &ParameterField{names: names, tc: test.tc, mode: pmIn}
+		_, err := f.Convert(test.v, nil, ConvertOptions{})
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%s %v: got error %v - expected %v", test.tc, test.v, err, test.err)
+		}
+		if !strings.HasPrefix(err.Error(), test.msg) {
+			t.Fatalf("%s %v: got error message %s - expected prefix %s", test.tc, test.v, err, test.msg)
+		}
+	}
+}
+
+func testConvertTimePrecision(t *testing.T) {
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+	v := time.Date(2024, 2, 29, 12, 13, 14, 123456789, time.UTC)
+
+	tests := []struct {
+		tc       typeCode
+		scale    int
+		expected time.Time
+	}{
+		{tcLongdate, 0, time.Date(2024, 2, 29, 12, 13, 14, 123456700, time.UTC)},
+		{tcLongdate, 3, time.Date(2024, 2, 29, 12, 13, 14, 123000000, time.UTC)},
+		{tcLongdate, 9, time.Date(2024, 2, 29, 12, 13, 14, 123456700, time.UTC)}, // scale exceeding type precision
+		{tcTimestamp, 0, time.Date(2024, 2, 29, 12, 13, 14, 123000000, time.UTC)},
+		{tcSeconddate, 0, time.Date(2024, 2, 29, 12, 13, 14, 0, time.UTC)},
+		{tcSecondtime, 0, time.Date(2024, 2, 29, 12, 13, 14, 0, time.UTC)},
+		{tcDaydate, 0, v}, // date only types are not truncated
+	}
+
+	for _, test := range tests {
+		f := &ParameterField{names: names, tc: test.tc, scale: test.scale, mode: pmIn}
+		cv, err := f.Convert(v, nil, ConvertOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cv.(time.Time).Equal(test.expected) {
+			t.Fatalf("%s scale %d: value %v - expected %v", test.tc, test.scale, cv, test.expected)
+		}
+		values, _, err := f.ConvertColumn([]time.Time{v}, ConvertOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !values[0].(time.Time).Equal(test.expected) {
+			t.Fatalf("%s scale %d: column value %v - expected %v", test.tc, test.scale, values[0], test.expected)
+		}
+	}
+}
+
+func testConvertBoolean(t *testing.T) {
+	type testBool bool
+
+	tests := []struct {
+		v        any
+		expected any
+		err      error
+	}{
+		{true, true, nil},
+		{false, false, nil},
+		{testBool(true), true, nil},
+		{int64(0), false, nil}, // int64 zero is false
+		{int8(1), true, nil},
+		{uint16(0), false, nil},
+		{1.0, true, nil},
+		{"true", true, nil},
+		{"0", false, nil},
+		{2, true, nil}, // numeric values other than zero are true
+		{int64(-1), true, nil},
+		{0.5, true, nil},
+	}
+
+	for _, test := range tests {
+		v, err := convertField(tcBoolean, test.v, nil)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%[1]T %[1]v: got error %[2]v - expected %[3]v", test.v, err, test.err)
+		}
+		if v != test.expected {
+			t.Fatalf("%[1]T %[1]v: value %[2]v - expected %[3]v", test.v, v, test.expected)
+		}
+	}
+
+	// legacy boolean representation (TINYINT)
+	for _, b := range []bool{true, false} {
+		v, err := convertField(tcTinyint, b, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := map[bool]int64{false: 0, true: 1}[b]; v != expected {
+			t.Fatalf("%t: value %v - expected %d", b, v, expected)
+		}
+	}
+}
+
+func testConvertColumn(t *testing.T) {
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+	date := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tc     typeCode
+		column any
+	}{
+		{tcTinyint, []int{0, 1, 255}},
+		{tcInteger, []int32{math.MinInt32, 0, math.MaxInt32}},
+		{tcBigint, []int64{math.MinInt64, 0, math.MaxInt64}},
+		{tcReal, []float64{-1.5, 0, math.MaxFloat32}},
+		{tcDouble, []float64{-1.5, 0, math.MaxFloat64}},
+		{tcBoolean, []bool{true, false}},
+		{tcTinyint, []bool{true, false}},
+		{tcNvarchar, []string{"a", "", "go-hdb"}},
+		{tcVarbinary, []string{"a"}},
+		{tcDaydate, []time.Time{date}},
+		{tcSecondtime, []time.Time{date}},
+	}
+
+	for _, test := range tests {
+		f := &ParameterField{names: names, tc: test.tc, mode: pmIn}
+		values, ok, err := f.ConvertColumn(test.column, ConvertOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatalf("%s %T: column conversion expected", test.tc, test.column)
+		}
+		// values need to equal the value by value conversion
+		rv := reflect.ValueOf(test.column)
+		for i, v := range values {
+			cv, err := f.Convert(rv.Index(i).Interface(), nil, ConvertOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v != cv {
+				t.Fatalf("%s %T row %d: value %v - expected %v", test.tc, test.column, i, v, cv)
+			}
+		}
+	}
+
+	// unsupported column types
+	unsupportedTests := []struct {
+		tc     typeCode
+		column any
+	}{
+		{tcInteger, []string{"1"}},
+		{tcDecimal, []int64{1}},
+		{tcNvarchar, []any{"a"}},
+		{tcBlob, []string{"a"}},
+	}
+	for _, test := range unsupportedTests {
+		f := &ParameterField{names: names, tc: test.tc, mode: pmIn}
+		if _, ok, err := f.ConvertColumn(test.column, ConvertOptions{}); ok || err != nil {
+			t.Fatalf("%s %T: got ok %t error %v - expected unsupported", test.tc, test.column, ok, err)
+		}
+	}
+
+	// out of range errors
+	errorTests := []struct {
+		tc     typeCode
+		column any
+		err    error
+		msg    string
+	}{
+		{tcTinyint, []int{1, 256}, errIntegerOutOfRange, "row 1: field F: cannot convert Go type int value 256 to HANA type TINYINT"},
+		{tcReal, []float64{math.MaxFloat64}, errFloatOutOfRange, "row 0: field F: cannot convert Go type float64"},
+		{tcDate, []time.Time{date, date.AddDate(8000, 0, 0)}, errDateOutOfRange, "row 1: field F: cannot convert Go type time.Time"},
+	}
+	for _, test := range errorTests {
+		f := &ParameterField{names: names, tc: test.tc, mode: pmIn}
+		_, _, err := f.ConvertColumn(test.column, ConvertOptions{})
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%s %v: got error %v - expected %v", test.tc, test.column, err, test.err)
+		}
+		if !strings.HasPrefix(err.Error(), test.msg) {
+			t.Fatalf("%s %v: got error message %s - expected prefix %s", test.tc, test.column, err, test.msg)
+		}
+	}
+}
+
 func TestConverter(t *testing.T) {
 	tests := []struct {
 		name string
@@ -201,8 +825,18 @@ func TestConverter(t *testing.T) {
 		{"convertInteger", testConvertInteger},
 		{"convertFloat", testConvertFloat},
 		{"convertTime", testConvertTime},
+		{"convertTimePrecision", testConvertTimePrecision},
 		{"convertString", testConvertString},
 		{"convertBytes", testConvertBytes},
+		{"convertRealVector", testConvertRealVector},
+		{"convertDecimalString", testConvertDecimalString},
+		{"convertLob", testConvertLob},
+		{"convertIntegerBoundaries", testConvertIntegerBoundaries},
+		{"convertLenient", testConvertLenient},
+		{"convertDecimalScale", testConvertDecimalScale},
+		{"convertError", testConvertError},
+		{"convertColumn", testConvertColumn},
+		{"convertBoolean", testConvertBoolean},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,58 @@
+package protocol
+
+import (
+	"fmt"
+)
+
+// typeNameCodes maps the database type names to the type codes supported by convertField.
+var typeNameCodes = func() map[string]typeCode {
+	m := map[string]typeCode{}
+	for _, tc := range []typeCode{
+		tcBoolean, tcTinyint, tcSmallint, tcInteger, tcBigint, tcReal, tcDouble,
+		tcDate, tcTimestamp, tcLongdate, tcSeconddate, tcDaydate, tcTime, tcSecondtime,
+		tcDecimal, tcFixed8, tcFixed12, tcFixed16,
+		tcChar, tcVarchar, tcString, tcAlphanum, tcNchar, tcNvarchar, tcNstring, tcShorttext, tcBinary, tcVarbinary, tcStPoint, tcStGeometry,
+		tcBlob, tcClob, tcLocator, tcNclob, tcText, tcNlocator, tcBintext,
+		tcRealVector,
+	} {
+		m[tc.typeName()] = tc
+	}
+	return m
+}()
+
+// ConvertedValue is a parameter value converted by a Converter.
+type ConvertedValue struct {
+	tc typeCode
+	v  any
+}
+
+// Converter converts parameter values to the database representation of a database type.
+// A Converter is safe for concurrent use.
+type Converter struct {
+	tc typeCode
+}
+
+// NewConverter returns a Converter for the database type typeName (e.g. INTEGER, NVARCHAR or DECIMAL).
+func NewConverter(typeName string) (*Converter, error) {
+	tc, ok := typeNameCodes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("invalid or not supported database type name %s", typeName)
+	}
+	return &Converter{tc: tc}, nil
+}
+
+// Convert converts v to a ConvertedValue. Nil values are returned as nil.
+// Lob values are returned unchanged, as lob conversion depends on the connection settings.
+func (c *Converter) Convert(v any) (any, error) {
+	if c.tc.isLob() {
+		return v, nil
+	}
+	cv, err := convertField(c.tc, v, nil)
+	if err != nil {
+		return nil, fmt.Errorf("type code %[1]s type %[2]T value %[2]v conversion error %[3]w", c.tc, v, err)
+	}
+	if cv == nil {
+		return nil, nil
+	}
+	return ConvertedValue{tc: c.tc, v: cv}, nil
+}
@@ -0,0 +1,59 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestPrecompiledConverter(t *testing.T) {
+	if _, err := NewConverter("NOTEXISTING"); err == nil {
+		t.Fatal("invalid type name error expected")
+	}
+
+	type testInt int16
+
+	conv, err := NewConverter("INTEGER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cv, err := conv.Convert(testInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cv != (ConvertedValue{tc: tcInteger, v: int64(42)}) {
+		t.Fatalf("got %v - expected converted value %d", cv, 42)
+	}
+
+	// same parameter type: no conversion
+	v, err := (&ParameterField{tc: tcInteger}).Convert(cv, nil, ConvertOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(42) {
+		t.Fatalf("got %v %[1]T - expected %d", v, 42)
+	}
+	// different parameter type: conversion (range check)
+	if _, err := (&ParameterField{tc: tcTinyint, names: &fieldNames{}}).Convert(ConvertedValue{tc: tcInteger, v: int64(256)}, nil, ConvertOptions{}); !errors.Is(err, errIntegerOutOfRange) {
+		t.Fatalf("got error %v - expected %v", err, errIntegerOutOfRange)
+	}
+
+	// nil
+	if cv, err := conv.Convert(nil); err != nil || cv != nil {
+		t.Fatalf("got %v %v - expected nil", cv, err)
+	}
+	// conversion error
+	if _, err := conv.Convert("abc"); err == nil {
+		t.Fatal("conversion error expected")
+	}
+
+	// lobs are not converted
+	conv, err = NewConverter("BLOB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := bytes.NewReader(nil)
+	if cv, err := conv.Convert(rd); err != nil || cv != rd {
+		t.Fatalf("got %v %v - expected unchanged value", cv, err)
+	}
+}
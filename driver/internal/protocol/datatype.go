@@ -27,6 +27,7 @@ const (
 	DtBytes
 	DtLob
 	DtRows
+	DtRealVector
 )
 
 // RegisterScanType registers driver owned datatype scantypes (e.g. Decimal, Lob).
@@ -40,20 +41,21 @@ var scanTypes = []struct {
 	scanType     reflect.Type
 	scanNullType reflect.Type
 }{
-	DtUnknown:  {hdbreflect.TypeFor[any](), hdbreflect.TypeFor[any]()},
-	DtBoolean:  {hdbreflect.TypeFor[bool](), hdbreflect.TypeFor[sql.NullBool]()},
-	DtTinyint:  {hdbreflect.TypeFor[uint8](), hdbreflect.TypeFor[sql.NullByte]()},
-	DtSmallint: {hdbreflect.TypeFor[int16](), hdbreflect.TypeFor[sql.NullInt16]()},
-	DtInteger:  {hdbreflect.TypeFor[int32](), hdbreflect.TypeFor[sql.NullInt32]()},
-	DtBigint:   {hdbreflect.TypeFor[int64](), hdbreflect.TypeFor[sql.NullInt64]()},
-	DtReal:     {hdbreflect.TypeFor[float32](), hdbreflect.TypeFor[sql.NullFloat64]()},
-	DtDouble:   {hdbreflect.TypeFor[float64](), hdbreflect.TypeFor[sql.NullFloat64]()},
-	DtTime:     {hdbreflect.TypeFor[time.Time](), hdbreflect.TypeFor[sql.NullTime]()},
-	DtString:   {hdbreflect.TypeFor[string](), hdbreflect.TypeFor[sql.NullString]()},
-	DtBytes:    {nil, nil}, // to be registered by driver
-	DtDecimal:  {nil, nil}, // to be registered by driver
-	DtLob:      {nil, nil}, // to be registered by driver
-	DtRows:     {hdbreflect.TypeFor[sql.Rows](), hdbreflect.TypeFor[sql.Rows]()},
+	DtUnknown:    {hdbreflect.TypeFor[any](), hdbreflect.TypeFor[any]()},
+	DtBoolean:    {hdbreflect.TypeFor[bool](), hdbreflect.TypeFor[sql.NullBool]()},
+	DtTinyint:    {hdbreflect.TypeFor[uint8](), hdbreflect.TypeFor[sql.NullByte]()},
+	DtSmallint:   {hdbreflect.TypeFor[int16](), hdbreflect.TypeFor[sql.NullInt16]()},
+	DtInteger:    {hdbreflect.TypeFor[int32](), hdbreflect.TypeFor[sql.NullInt32]()},
+	DtBigint:     {hdbreflect.TypeFor[int64](), hdbreflect.TypeFor[sql.NullInt64]()},
+	DtReal:       {hdbreflect.TypeFor[float32](), hdbreflect.TypeFor[sql.NullFloat64]()},
+	DtDouble:     {hdbreflect.TypeFor[float64](), hdbreflect.TypeFor[sql.NullFloat64]()},
+	DtTime:       {hdbreflect.TypeFor[time.Time](), hdbreflect.TypeFor[sql.NullTime]()},
+	DtString:     {hdbreflect.TypeFor[string](), hdbreflect.TypeFor[sql.NullString]()},
+	DtBytes:      {nil, nil}, // to be registered by driver
+	DtDecimal:    {nil, nil}, // to be registered by driver
+	DtLob:        {nil, nil}, // to be registered by driver
+	DtRows:       {hdbreflect.TypeFor[sql.Rows](), hdbreflect.TypeFor[sql.Rows]()},
+	DtRealVector: {nil, nil}, // to be registered by driver
 }
 
 // ScanType return the scan type (reflect.Type) of the corresponding data type.
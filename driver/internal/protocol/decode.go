@@ -1,13 +1,15 @@
 package protocol
 
 import (
+	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 )
 
-func decodeLobResult(d *encoding.Decoder, isCharBased bool) (any, error) {
-	descr := &LobOutDescr{IsCharBased: isCharBased}
+func decodeLobResult(d *encoding.Decoder, isCharBased, isClob bool) (any, error) {
+	descr := &LobOutDescr{IsCharBased: isCharBased, IsClob: isClob}
 	descr.ltc = lobTypecode(d.Int8())
 	descr.Opt = LobOptions(d.Int8())
 	if descr.Opt.isNull() {
@@ -23,6 +25,25 @@ func decodeLobResult(d *encoding.Decoder, isCharBased bool) (any, error) {
 	return descr, nil
 }
 
+// trimCharField decodes a fixed length character field and removes the trailing blanks in case the decoder trim char flag is set.
+func trimCharField(d *encoding.Decoder, decodeField func() (any, error)) (any, error) {
+	v, err := decodeField()
+	if err != nil || v == nil || !d.TrimChar() {
+		return v, err
+	}
+	return bytes.TrimRight(v.([]byte), " "), nil
+}
+
+// locationField decodes a date or timestamp field and returns the wall clock time in the decoder location if set.
+func locationField(d *encoding.Decoder, decodeField func() (any, error)) (any, error) {
+	v, err := decodeField()
+	if err != nil || v == nil || d.Location() == nil {
+		return v, err
+	}
+	t := v.(time.Time)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), d.Location()), nil
+}
+
 func decodeResult(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 	switch tc {
 	case tcBoolean:
@@ -52,17 +73,17 @@ func decodeResult(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 	case tcDouble:
 		return d.DoubleField()
 	case tcDate:
-		return d.DateField()
+		return locationField(d, d.DateField)
 	case tcTime:
 		return d.TimeField()
 	case tcTimestamp:
-		return d.TimestampField()
+		return locationField(d, d.TimestampField)
 	case tcLongdate:
-		return d.LongdateField()
+		return locationField(d, d.LongdateField)
 	case tcSeconddate:
-		return d.SeconddateField()
+		return locationField(d, d.SeconddateField)
 	case tcDaydate:
-		return d.DaydateField()
+		return locationField(d, d.DaydateField)
 	case tcSecondtime:
 		return d.SecondtimeField()
 	case tcDecimal:
@@ -73,20 +94,31 @@ func decodeResult(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 		return d.Fixed12Field(scale)
 	case tcFixed16:
 		return d.Fixed16Field(scale)
-	case tcChar, tcVarchar, tcString, tcBinary, tcVarbinary:
+	case tcChar:
+		return trimCharField(d, d.VarField)
+	case tcVarchar, tcString, tcBinary, tcVarbinary:
 		return d.VarField()
 	case tcAlphanum:
 		return d.AlphanumField()
-	case tcNchar, tcNvarchar, tcNstring, tcShorttext:
+	case tcNchar:
+		return trimCharField(d, d.Cesu8Field)
+	case tcNvarchar, tcNstring, tcShorttext:
 		return d.Cesu8Field()
 	case tcStPoint, tcStGeometry:
 		return d.HexField()
-	case tcBlob, tcClob, tcLocator, tcBintext:
-		return decodeLobResult(d, false)
+	case tcRealVector:
+		return d.RealVectorField()
+	case tcBlob, tcLocator, tcBintext:
+		return decodeLobResult(d, false, false)
+	case tcClob:
+		return decodeLobResult(d, false, true)
 	case tcText, tcNclob, tcNlocator:
-		return decodeLobResult(d, true)
+		return decodeLobResult(d, true, false)
 	default:
-		panic(fmt.Sprintf("invalid type code %s", tc))
+		if d.UnknownTypeAsBytes() { // assume length indicator encoding
+			return d.VarField()
+		}
+		return nil, fmt.Errorf("%w %s", errUnknownTypeCode, tc.typeName())
 	}
 }
 
@@ -119,17 +151,17 @@ func decodeParameter(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 	case tcDouble:
 		return d.DoubleField()
 	case tcDate:
-		return d.DateField()
+		return locationField(d, d.DateField)
 	case tcTime:
 		return d.TimeField()
 	case tcTimestamp:
-		return d.TimestampField()
+		return locationField(d, d.TimestampField)
 	case tcLongdate:
-		return d.LongdateField()
+		return locationField(d, d.LongdateField)
 	case tcSeconddate:
-		return d.SeconddateField()
+		return locationField(d, d.SeconddateField)
 	case tcDaydate:
-		return d.DaydateField()
+		return locationField(d, d.DaydateField)
 	case tcSecondtime:
 		return d.SecondtimeField()
 	case tcDecimal:
@@ -148,11 +180,16 @@ func decodeParameter(tc typeCode, d *encoding.Decoder, scale int) (any, error) {
 		return d.Cesu8Field()
 	case tcStPoint, tcStGeometry:
 		return d.HexField()
+	case tcRealVector:
+		return d.RealVectorField()
 	case tcBlob, tcClob, tcLocator, tcBintext:
 		return decodeLobParameter(d)
 	case tcText, tcNclob, tcNlocator:
 		return decodeLobParameter(d)
 	default:
-		panic(fmt.Sprintf("invalid type code %s", tc))
+		if d.UnknownTypeAsBytes() { // assume length indicator encoding
+			return d.VarField()
+		}
+		return nil, fmt.Errorf("%w %s", errUnknownTypeCode, tc.typeName())
 	}
 }
@@ -0,0 +1,367 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+func TestDecodeUnknownType(t *testing.T) {
+	const tcUnknown typeCode = 0x62 // simulated type code unknown to the driver
+
+	if tcUnknown.isKnown() {
+		t.Fatalf("type code %d is known", tcUnknown)
+	}
+	if typeName := tcUnknown.typeName(); typeName != "UNKNOWN(98)" {
+		t.Fatalf("type name %s - expected UNKNOWN(98)", typeName)
+	}
+	if dt := tcUnknown.dataType(); dt != DtBytes {
+		t.Fatalf("data type %s - expected %s", dt, DtBytes)
+	}
+
+	b := []byte("raw field data")
+	data := append([]byte{byte(len(b))}, b...) // length indicator + data
+
+	dec := encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+	dec.SetUnknownTypeAsBytes(true)
+
+	v, err := decodeResult(tcUnknown, dec, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rb, ok := v.([]byte); !ok || !slices.Equal(rb, b) {
+		t.Fatalf("value %v - expected %v", v, b)
+	}
+
+	// strict mode
+	dec = encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+	if _, err := decodeResult(tcUnknown, dec, 0); !errors.Is(err, errUnknownTypeCode) {
+		t.Fatalf("error %v - expected %v", err, errUnknownTypeCode)
+	}
+	if _, err := decodeParameter(tcUnknown, dec, 0); !errors.Is(err, errUnknownTypeCode) {
+		t.Fatalf("error %v - expected %v", err, errUnknownTypeCode)
+	}
+
+	// strict mode: rows with fields of unknown type are not decoded, but returned with decode errors
+	const numRow = 2
+	fields := []*ResultField{{tc: tcInteger, names: &fieldNames{}}, {tc: tcUnknown, names: &fieldNames{}}}
+	dec = encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+	rs := &Resultset{ResultFields: fields}
+	if err := rs.decodeNumArg(dec, numRow); err != nil {
+		t.Fatal(err)
+	}
+	if dec.Cnt() != 0 {
+		t.Fatalf("decoded bytes %d - expected %d", dec.Cnt(), 0)
+	}
+	for i := 0; i < numRow; i++ {
+		if err := rs.DecodeErrors.RowError(i); err == nil || !strings.Contains(err.Error(), "UNKNOWN(98)") {
+			t.Fatalf("row %d: error %v - expected unknown type code error", i, err)
+		}
+	}
+}
+
+func TestDecodeTrimChar(t *testing.T) {
+	b := []byte("go-hdb    ")
+	data := append([]byte{byte(len(b))}, b...) // length indicator + data
+
+	testData := []struct {
+		tc       typeCode
+		trimChar bool
+		v        string
+	}{
+		{tcChar, false, "go-hdb    "},
+		{tcChar, true, "go-hdb"},
+		{tcNchar, true, "go-hdb"},
+		{tcVarchar, true, "go-hdb    "},
+		{tcNvarchar, true, "go-hdb    "},
+	}
+
+	for _, r := range testData {
+		dec := encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+		dec.SetTrimChar(r.trimChar)
+		v, err := decodeResult(r.tc, dec, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rb, ok := v.([]byte); !ok || string(rb) != r.v {
+			t.Fatalf("type code %s trim char %t: value %q - expected %q", r.tc, r.trimChar, v, r.v)
+		}
+	}
+}
+
+func TestDecodeColumnarResultset(t *testing.T) {
+	const numRow = 3
+
+	fields := []*ResultField{
+		{tc: tcInteger, names: &fieldNames{}},
+		{tc: tcBigint, names: &fieldNames{}},
+	}
+
+	// column wise data: all values of the first column followed by all values of the second column
+	buf := &bytes.Buffer{}
+	enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder)
+	for i := 0; i < numRow; i++ {
+		enc.Bool(true) // not null
+		enc.Int32(int32(i))
+	}
+	for i := 0; i < numRow; i++ {
+		enc.Bool(true) // not null
+		enc.Int64(int64(i * 10))
+	}
+
+	dec := encoding.NewDecoder(buf, cesu8.DefaultDecoder)
+	dec.SetColumnarResultSet(true)
+
+	rs := &Resultset{ResultFields: fields}
+	if err := rs.decodeNumArg(dec, numRow); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < numRow; i++ {
+		if v0, v1 := rs.FieldValues[i*2], rs.FieldValues[i*2+1]; v0 != int64(i) || v1 != int64(i*10) {
+			t.Fatalf("row %d: values %v %v - expected %d %d", i, v0, v1, i, i*10)
+		}
+	}
+}
+
+func TestDecodeNullNumericAsZero(t *testing.T) {
+	tests := []struct {
+		tc   typeCode
+		data []byte // null value
+		v    any
+	}{
+		{tcInteger, []byte{0}, int64(0)},
+		{tcDouble, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, float64(0)},
+		{tcDaydate, []byte{0xde, 0xb9, 0x37, 0x00}, time.Time{}},
+		{tcNvarchar, []byte{0xff}, nil}, // non numeric types are not affected
+	}
+
+	for _, test := range tests {
+		for _, asZero := range []bool{false, true} {
+			dec := encoding.NewDecoder(bytes.NewBuffer(test.data), cesu8.DefaultDecoder)
+			dec.SetNullNumericAsZero(asZero)
+
+			f := &ResultField{tc: test.tc, names: &fieldNames{}}
+			v, err := f.decodeResult(dec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := test.v
+			if !asZero {
+				expected = nil
+			}
+			if v != expected {
+				t.Fatalf("type code %s null numeric as zero %t: value %v - expected %v", test.tc, asZero, v, expected)
+			}
+		}
+	}
+}
+
+// encodeWideResultset encodes numRow rows of numCol nvarchar and varbinary fields (alternating).
+func encodeWideResultset(numRow, numCol int) ([]*ResultField, []byte) {
+	fields := make([]*ResultField, numCol)
+	for j := range fields {
+		tc := tcNvarchar
+		if j%2 == 1 {
+			tc = tcVarbinary
+		}
+		fields[j] = &ResultField{tc: tc, names: &fieldNames{}}
+	}
+	buf := &bytes.Buffer{}
+	enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder)
+	for i := 0; i < numRow; i++ {
+		for j := 0; j < numCol; j++ {
+			enc.LIBytes([]byte(fmt.Sprintf("row %d column %d", i, j))) //nolint:errcheck
+		}
+	}
+	return fields, buf.Bytes()
+}
+
+func TestDecodeResultsetValueBuffer(t *testing.T) {
+	const numRow, numCol = 10, 4
+
+	fields, data := encodeWideResultset(numRow, numCol)
+
+	rs := &Resultset{ResultFields: fields, ValueBuffer: []byte{}}
+	var capacity int
+	for k := 0; k < 2; k++ { // decode twice to reuse the buffer
+		dec := encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+		if err := rs.decodeNumArg(dec, numRow); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < numRow; i++ {
+			for j := 0; j < numCol; j++ {
+				v := rs.FieldValues[i*numCol+j]
+				if b, ok := v.([]byte); !ok || string(b) != fmt.Sprintf("row %d column %d", i, j) {
+					t.Fatalf("row %d column %d: value %v", i, j, v)
+				}
+			}
+		}
+		if k == 0 {
+			capacity = cap(rs.ValueBuffer)
+			continue
+		}
+		if cap(rs.ValueBuffer) != capacity {
+			t.Fatalf("value buffer capacity %d - expected %d", cap(rs.ValueBuffer), capacity)
+		}
+	}
+	if dec := encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder); dec.ValueBuffer() != nil {
+		t.Fatal("value buffer is not expected to be set by default")
+	}
+}
+
+func BenchmarkDecodeWideResultset(b *testing.B) {
+	const numRow, numCol = 32, 300
+
+	fields, data := encodeWideResultset(numRow, numCol)
+
+	decode := func(b *testing.B, valueBuffer []byte) {
+		rs := &Resultset{ResultFields: fields, ValueBuffer: valueBuffer}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			dec := encoding.NewDecoder(bytes.NewReader(data), cesu8.DefaultDecoder)
+			if err := rs.decodeNumArg(dec, numRow); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("allocate values", func(b *testing.B) { decode(b, nil) })
+	b.Run("reuse value buffer", func(b *testing.B) { decode(b, []byte{}) })
+}
+
+func TestDecodeRealVector(t *testing.T) {
+	large := make([]float32, 3000) // exceeds encoder and decoder scratch buffers
+	for i := range large {
+		large[i] = float32(i) / 3
+	}
+
+	tests := [][]float32{{1.5, -2, 3}, {}, large}
+
+	buf := &bytes.Buffer{}
+	enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder)
+	for _, v := range tests {
+		if err := enc.RealVectorField(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	enc.Byte(0xff) // null value
+
+	dec := encoding.NewDecoder(buf, cesu8.DefaultDecoder)
+	for _, v := range tests {
+		rv, err := decodeResult(tcRealVector, dec, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fv, ok := rv.([]float32); !ok || !slices.Equal(fv, v) {
+			t.Fatalf("value %v - expected %v", rv, v)
+		}
+	}
+	rv, err := decodeResult(tcRealVector, dec, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv != nil {
+		t.Fatalf("value %v - expected nil", rv)
+	}
+
+	// invalid size
+	dec = encoding.NewDecoder(bytes.NewBuffer([]byte{8, 3, 0, 0, 0, 0, 0, 0, 0}), cesu8.DefaultDecoder)
+	if _, err := decodeResult(tcRealVector, dec, 0); err == nil {
+		t.Fatal("invalid real vector field size error expected")
+	}
+	if err := dec.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	// read error
+	dec = encoding.NewDecoder(bytes.NewBuffer([]byte{12, 2, 0, 0, 0, 0, 0}), cesu8.DefaultDecoder)
+	if _, err := decodeResult(tcRealVector, dec, 0); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("got error %v - expected %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func BenchmarkDecodeRealVector(b *testing.B) {
+	const numRow, dim = 32, 1536
+
+	v := make([]float32, dim)
+	buf := &bytes.Buffer{}
+	enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder)
+	for i := 0; i < numRow; i++ {
+		enc.RealVectorField(v) //nolint:errcheck
+	}
+	data := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dec := encoding.NewDecoder(bytes.NewReader(data), cesu8.DefaultDecoder)
+		for j := 0; j < numRow; j++ {
+			if _, err := decodeResult(tcRealVector, dec, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestDecodeLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	names := &fieldNames{items: []ofsName{{ofs: 0, name: "F"}}}
+	v := time.Date(2024, 2, 29, 23, 13, 14, 0, time.UTC)
+
+	testData := []struct {
+		tc     typeCode
+		encode func(enc *encoding.Encoder, v any) error
+		wall   time.Time // stored wall clock time
+	}{
+		{tcLongdate, (*encoding.Encoder).LongdateField, time.Date(2024, 3, 1, 1, 13, 14, 0, time.UTC)},
+		{tcSeconddate, (*encoding.Encoder).SeconddateField, time.Date(2024, 3, 1, 1, 13, 14, 0, time.UTC)},
+		{tcDaydate, (*encoding.Encoder).DaydateField, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, r := range testData {
+		f := &ParameterField{names: names, tc: r.tc, mode: pmIn}
+		cv, err := f.Convert(v, nil, ConvertOptions{Location: loc})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cv.(time.Time).Equal(time.Date(2024, 3, 1, 1, 13, 14, 0, time.UTC)) {
+			t.Fatalf("type code %s: converted value %v - expected wall clock time in %s", r.tc, cv, loc)
+		}
+
+		buf := &bytes.Buffer{}
+		if err := r.encode(encoding.NewEncoder(buf, cesu8.DefaultEncoder), cv); err != nil {
+			t.Fatal(err)
+		}
+		data := buf.Bytes()
+
+		// default: wall clock time in UTC
+		dec := encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+		dv, err := decodeResult(r.tc, dec, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dv != r.wall {
+			t.Fatalf("type code %s: value %v - expected %v", r.tc, dv, r.wall)
+		}
+
+		// location: wall clock time in loc
+		dec = encoding.NewDecoder(bytes.NewBuffer(data), cesu8.DefaultDecoder)
+		dec.SetLocation(loc)
+		if dv, err = decodeResult(r.tc, dec, 0); err != nil {
+			t.Fatal(err)
+		}
+		expected := time.Date(r.wall.Year(), r.wall.Month(), r.wall.Day(), r.wall.Hour(), r.wall.Minute(), r.wall.Second(), 0, loc)
+		if dt := dv.(time.Time); !dt.Equal(expected) || dt.Location() != loc {
+			t.Fatalf("type code %s location %s: value %v - expected %v", r.tc, loc, dv, expected)
+		}
+	}
+}
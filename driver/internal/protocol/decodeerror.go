@@ -1,6 +1,10 @@
 package protocol
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+)
 
 // DecodeError represents a decoding error.
 type DecodeError struct {
@@ -25,3 +29,32 @@ func (errors DecodeErrors) RowError(row int) error {
 	}
 	return nil
 }
+
+// decodeField is a field whose values are decoded by a part (result or output parameter field).
+type decodeField interface {
+	Name() string
+	fieldTypeCode() typeCode
+}
+
+/*
+unknownTypeErrors returns a decode error for each of the numRow rows in case a field is of a data type unknown
+to the driver and the field values cannot be returned as bytes (see encoding.Decoder.UnknownTypeAsBytes).
+As the size of the field values is unknown, none of the row values can be decoded and the rest of the
+part is skipped by the reader. The protocol only transmits the type code, so the error contains the
+type code but not the database type name of the field.
+*/
+func unknownTypeErrors[F decodeField](dec *encoding.Decoder, fields []F, numRow int) DecodeErrors {
+	if dec.UnknownTypeAsBytes() {
+		return nil
+	}
+	for _, f := range fields {
+		if tc := f.fieldTypeCode(); !tc.isKnown() {
+			errs := make(DecodeErrors, numRow)
+			for row := 0; row < numRow; row++ {
+				errs[row] = &DecodeError{row: row, fieldName: f.Name(), s: fmt.Sprintf("%s %s", errUnknownTypeCode, tc.typeName())}
+			}
+			return errs
+		}
+	}
+	return nil
+}
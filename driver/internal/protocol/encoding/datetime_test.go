@@ -0,0 +1,38 @@
+package encoding
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDatetimeConversion(t *testing.T) {
+	testData := []time.Time{
+		time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1, time.December, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(333, time.January, 27, 12, 0, 0, 0, time.UTC),
+		time.Date(1000, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1500, time.February, 28, 8, 15, 30, 0, time.UTC), // pre-1600 (Julian calendar)
+		time.Date(1582, time.October, 4, 23, 59, 59, 0, time.UTC),  // last day of Julian calendar
+		time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC),    // first day of Gregorian calendar
+		time.Date(1599, time.December, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(1600, time.February, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
+	}
+
+	for _, v := range testData {
+		if r := convertDaydateToTime(convertTimeToDayDate(v)); !r.Equal(v.Truncate(24 * time.Hour)) {
+			t.Fatalf("daydate: got %s - expected %s", r, v.Truncate(24*time.Hour))
+		}
+		if r := convertSeconddateToTime(convertTimeToSeconddate(v)); !r.Equal(v) {
+			t.Fatalf("seconddate: got %s - expected %s", r, v)
+		}
+		nv := v.Add(1234567 * 100) // longdate precision: 100 nanoseconds
+		if r := convertLongdateToTime(convertTimeToLongdate(nv)); !r.Equal(nv) {
+			t.Fatalf("longdate: got %s - expected %s", r, nv)
+		}
+	}
+}
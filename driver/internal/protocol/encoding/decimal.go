@@ -183,40 +183,38 @@ func convertDecimalToRat(m *big.Int, exp int) *big.Rat {
 	return v
 }
 
-func convertRatToFixed(r *big.Rat, m *big.Int, prec, scale int) byte {
+/*
+convertRatToFixed converts r into the fixed decimal significand m.
+performance (bulk operations):
+  - integer values (denominator equals one) are converted without division
+  - the division is done without normalizing the fraction first (rounding is not affected by a common divisor)
+  - rest is used as work variable (allocation free if rest is reused by the caller)
+*/
+func convertRatToFixed(r *big.Rat, m, rest *big.Int, prec, scale int) byte {
 	if scale < 0 {
 		panic(fmt.Sprintf("fixed: invalid scale: %d", scale))
 	}
 
 	var df byte
 
-	m.Set(r.Num())
-	m.Mul(m, exp10(scale))
-
-	var tmp big.Rat
-
-	c := (&tmp).SetFrac(m, r.Denom()) // norm
-	a := c.Num()
-	b := c.Denom()
-
-	if b.Cmp(natZero) == 0 { //
-		m.Set(a)
-		return df
-	}
-
-	m.QuoRem(a, b, a) // reuse a as rest
-	if a.Cmp(natZero) != 0 {
-		// round (business >= 0.5 up)
-		df |= dfNotExact
-		if a.Add(a, a).Cmp(b) >= 0 {
-			m.Add(m, natOne)
+	m.Mul(r.Num(), exp10(scale))
+
+	if b := r.Denom(); !r.IsInt() {
+		m.QuoRem(m, b, rest)
+		if rest.Sign() != 0 {
+			// round (business >= 0.5 up, negative values symmetrically away from zero)
+			df |= dfNotExact
+			if rest.Add(rest, rest).CmpAbs(b) >= 0 {
+				if rest.Sign() < 0 { // rest has the sign of the numerator
+					m.Sub(m, natOne)
+				} else {
+					m.Add(m, natOne)
+				}
+			}
 		}
 	}
 
-	max := exp10(prec)
-	min := new(big.Int).Neg(max)
-
-	if m.Cmp(min) <= 0 || m.Cmp(max) >= 0 {
+	if m.CmpAbs(exp10(prec)) >= 0 {
 		df |= dfOverflow
 	}
 	return df
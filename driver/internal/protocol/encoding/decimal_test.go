@@ -1,8 +1,11 @@
 package encoding
 
 import (
+	"io"
 	"math/big"
 	"testing"
+
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
 )
 
 func testDigits10(t *testing.T) {
@@ -112,15 +115,21 @@ func testConvertRatToFixed(t *testing.T) {
 		{new(big.Rat).SetFrac64(1, 2), 1, 0, new(big.Int).SetInt64(1), dfNotExact},        // convert 1/2 - should round to 1
 		{new(big.Rat).SetFrac64(4999, 10000), 1, 0, new(big.Int).SetInt64(0), dfNotExact}, // convert 0,4999 - should round to 0
 
-		{new(big.Rat).SetFrac64(1000, 1), 3, 0, new(big.Int).SetInt64(1000), dfOverflow}, // convert 1000 - prec 3 - should overflow
-		{new(big.Rat).SetFrac64(10, 1), 3, 2, new(big.Int).SetInt64(1000), dfOverflow},   // convert 10 - prec 3, scale 2 - should overflow
+		{new(big.Rat).SetFrac64(1000, 1), 3, 0, new(big.Int).SetInt64(1000), dfOverflow},   // convert 1000 - prec 3 - should overflow
+		{new(big.Rat).SetFrac64(10, 1), 3, 2, new(big.Int).SetInt64(1000), dfOverflow},     // convert 10 - prec 3, scale 2 - should overflow
+		{new(big.Rat).SetFrac64(-1000, 1), 3, 0, new(big.Int).SetInt64(-1000), dfOverflow}, // convert -1000 - prec 3 - should overflow
+
+		{new(big.Rat).SetFrac64(12345, 1000), 10, 2, new(big.Int).SetInt64(1235), dfNotExact},   // convert 12.345 - scale 2 - should round to 12.35
+		{new(big.Rat).SetFrac64(-12345, 1000), 10, 2, new(big.Int).SetInt64(-1235), dfNotExact}, // convert -12.345 - scale 2 - should round to -12.35
+		{new(big.Rat).SetFrac64(-1, 2), 1, 0, new(big.Int).SetInt64(-1), dfNotExact},            // convert -1/2 - should round to -1
+		{new(big.Rat).SetFrac64(1, 3), 10, 4, new(big.Int).SetInt64(3333), dfNotExact},          // convert 1/3 - scale 4
 	}
 
-	m := new(big.Int)
+	m, rest := new(big.Int), new(big.Int)
 
 	for i := 0; i < 1; i++ { // use for performance tests
 		for j, d := range testData {
-			df := convertRatToFixed(d.x, m, d.prec, d.scale)
+			df := convertRatToFixed(d.x, m, rest, d.prec, d.scale)
 			if m.Cmp(d.cmp) != 0 || df != d.df {
 				t.Fatalf("converted %d value m %s df %b - expected m %s df %b (prec %d scale %d)", j, m, df, d.cmp, d.df, d.prec, d.scale)
 			}
@@ -128,6 +137,27 @@ func testConvertRatToFixed(t *testing.T) {
 	}
 }
 
+func BenchmarkEncodeFixed(b *testing.B) {
+	const prec, scale = 18, 2
+
+	values := make([]*big.Rat, 1000)
+	for i := range values {
+		values[i] = big.NewRat(int64(i*100+i%100), 100)
+	}
+
+	enc := NewEncoder(io.Discard, cesu8.DefaultEncoder)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range values {
+			if err := enc.Fixed8Field(v, prec, scale); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
 func TestDecimal(t *testing.T) {
 	tests := []struct {
 		name string
@@ -27,9 +27,17 @@ type Decoder struct {
 	tr  transform.Transformer
 	cnt int
 
+	valueBuf    []byte // buffer for variable length field values (see SetValueBuffer)
+	useValueBuf bool
+
 	// decoder options
-	alphanumDfv1    bool
-	emptyDateAsNull bool
+	alphanumDfv1       bool
+	emptyDateAsNull    bool
+	unknownTypeAsBytes bool
+	trimChar           bool
+	columnarResultSet  bool
+	nullNumericAsZero  bool
+	location           *time.Location
 }
 
 // NewDecoder creates a new Decoder instance based on an io.Reader.
@@ -50,6 +58,63 @@ func (d *Decoder) EmptyDateAsNull() bool { return d.emptyDateAsNull }
 // SetEmptyDateAsNull sets the empty date as null flag.
 func (d *Decoder) SetEmptyDateAsNull(emptyDateAsNull bool) { d.emptyDateAsNull = emptyDateAsNull }
 
+// UnknownTypeAsBytes returns the unknown type as bytes flag.
+func (d *Decoder) UnknownTypeAsBytes() bool { return d.unknownTypeAsBytes }
+
+// SetUnknownTypeAsBytes sets the unknown type as bytes flag.
+func (d *Decoder) SetUnknownTypeAsBytes(unknownTypeAsBytes bool) {
+	d.unknownTypeAsBytes = unknownTypeAsBytes
+}
+
+// Location returns the location of date and timestamp wall clock times (nil: UTC).
+func (d *Decoder) Location() *time.Location { return d.location }
+
+// SetLocation sets the location of date and timestamp wall clock times.
+func (d *Decoder) SetLocation(location *time.Location) { d.location = location }
+
+// TrimChar returns the trim char flag.
+func (d *Decoder) TrimChar() bool { return d.trimChar }
+
+// SetTrimChar sets the trim char flag.
+func (d *Decoder) SetTrimChar(trimChar bool) { d.trimChar = trimChar }
+
+// ColumnarResultSet returns the columnar result set flag.
+func (d *Decoder) ColumnarResultSet() bool { return d.columnarResultSet }
+
+// SetColumnarResultSet sets the columnar result set flag.
+func (d *Decoder) SetColumnarResultSet(columnar bool) { d.columnarResultSet = columnar }
+
+// NullNumericAsZero returns the null numeric as zero flag.
+func (d *Decoder) NullNumericAsZero() bool { return d.nullNumericAsZero }
+
+// SetNullNumericAsZero sets the null numeric as zero flag.
+func (d *Decoder) SetNullNumericAsZero(asZero bool) { d.nullNumericAsZero = asZero }
+
+/*
+SetValueBuffer enables (b != nil) or disables (b == nil) the decoding of variable length field values
+(LIBytes, CESU8Bytes) into buffer b instead of allocating a byte slice per value. The buffer is grown
+if needed (see ValueBuffer) and the values are only valid until the buffer is reused.
+*/
+func (d *Decoder) SetValueBuffer(b []byte) { d.valueBuf, d.useValueBuf = b[:0], b != nil }
+
+// ValueBuffer returns the value buffer, which might got reallocated during decoding.
+func (d *Decoder) ValueBuffer() []byte { return d.valueBuf }
+
+// alloc returns a byte slice of size n, either taken from the value buffer or allocated.
+func (d *Decoder) alloc(n int) []byte {
+	if !d.useValueBuf {
+		return make([]byte, n)
+	}
+	l := len(d.valueBuf)
+	if l+n > cap(d.valueBuf) {
+		// values decoded so far keep referencing the old buffer.
+		d.valueBuf = make([]byte, 0, max(2*cap(d.valueBuf), n, readScratchSize))
+		l = 0
+	}
+	d.valueBuf = d.valueBuf[:l+n]
+	return d.valueBuf[l : l+n : l+n]
+}
+
 // Cnt returns the value of the byte read counter.
 func (d *Decoder) Cnt() int { return d.cnt }
 
@@ -295,6 +360,15 @@ func (d *Decoder) CESU8Bytes(size int) ([]byte, error) {
 		return nil, nil
 	}
 
+	if d.useValueBuf {
+		// the size of the UTF-8 representation does not exceed the size of the CESU-8 representation
+		// unless the transformer does replace invalid characters.
+		d.tr.Reset()
+		b := d.alloc(size)
+		if nDst, _, err := d.tr.Transform(b, p, true); err != transform.ErrShortDst {
+			return b[:nDst], err
+		}
+	}
 	b, _, err := transform.Bytes(d.tr, p)
 	return b, err
 }
@@ -322,7 +396,7 @@ func (d *Decoder) LIBytes() (n int, b []byte) {
 	if null {
 		return n, nil
 	}
-	b = make([]byte, size)
+	b = d.alloc(size)
 	d.Bytes(b)
 	return n + size, b
 }
@@ -579,6 +653,43 @@ func (d *Decoder) Cesu8Field() (any, error) {
 	return b, nil
 }
 
+/*
+RealVectorField decodes a real vector field (length indicator followed by the vector dimension and
+the little endian encoded float32 values).
+*/
+func (d *Decoder) RealVectorField() (any, error) {
+	_, size, null := d.varFieldInd()
+	/*
+	   caution:
+	   - result is used as driver.Value and we do need to provide a 'real' nil value
+	   - returning a nil []float32 slice does not work because of the slice type
+	*/
+	if null {
+		return nil, nil
+	}
+	if size < 4 {
+		d.Skip(size)
+		return nil, fmt.Errorf("invalid real vector field size %d", size)
+	}
+	dim := int(d.Int32())
+	if size != RealVectorSize(dim) {
+		d.Skip(size - 4)
+		return nil, fmt.Errorf("invalid real vector field size %d for dimension %d", size, dim)
+	}
+	v := make([]float32, dim)
+	for i := 0; i < dim; {
+		n := min(dim-i, readScratchSize/4)
+		if _, err := d.readFull(d.b[:n*4]); err != nil {
+			return nil, err
+		}
+		for j := 0; j < n; j++ {
+			v[i+j] = math.Float32frombits(binary.LittleEndian.Uint32(d.b[j*4:]))
+		}
+		i += n
+	}
+	return v, nil
+}
+
 // HexField decodes a hex field.
 func (d *Decoder) HexField() (any, error) {
 	_, b := d.LIBytes()
@@ -22,6 +22,9 @@ type Encoder struct {
 	wr io.Writer
 	b  []byte // scratch buffer (min 15 Bytes - Decimal)
 	tr transform.Transformer
+
+	// fixed decimal work variables (reused to avoid allocations in bulk operations)
+	fixedM, fixedRest big.Int
 }
 
 // NewEncoder creates a new Encoder instance.
@@ -447,14 +450,13 @@ func (e *Encoder) encodeFixed(v any, size, prec, scale int) error {
 		panic(formatInvalidValue("fixed", v)) // should never happen
 	}
 
-	var m big.Int
-	df := convertRatToFixed(r, &m, prec, scale)
+	df := convertRatToFixed(r, &e.fixedM, &e.fixedRest, prec, scale)
 
 	if df&dfOverflow != 0 {
 		return ErrDecimalOutOfRange
 	}
 
-	e.Fixed(&m, size)
+	e.Fixed(&e.fixedM, size)
 	return nil
 }
 
@@ -519,6 +521,27 @@ func (e *Encoder) Cesu8Field(v any) error {
 	}
 }
 
+// RealVectorField encodes a real vector field.
+func (e *Encoder) RealVectorField(v any) error {
+	fv, ok := v.([]float32)
+	if !ok {
+		panic(formatInvalidValue("real vector", v)) // should never happen
+	}
+	if err := e.varFieldInd(RealVectorSize(len(fv))); err != nil {
+		return err
+	}
+	e.Int32(int32(len(fv)))
+	for i := 0; i < len(fv); {
+		n := min(len(fv)-i, len(e.b)/4)
+		for j := 0; j < n; j++ {
+			binary.LittleEndian.PutUint32(e.b[j*4:], math.Float32bits(fv[i+j]))
+		}
+		e.wr.Write(e.b[:n*4]) //nolint:errcheck
+		i += n
+	}
+	return nil
+}
+
 // HexField encodes a hex field.
 func (e *Encoder) HexField(v any) error {
 	switch v := v.(type) {
@@ -94,6 +94,18 @@ func VarFieldSize(v any) int {
 	}
 }
 
+// RealVectorSize returns the size of the data of a real vector of dimension dim.
+func RealVectorSize(dim int) int { return 4 + 4*dim }
+
+// RealVectorFieldSize returns the size of a real vector field.
+func RealVectorFieldSize(v any) int {
+	fv, ok := v.([]float32)
+	if !ok {
+		panic(fmt.Sprintf("invalid type %T for real vector field", v))
+	}
+	return varSize(RealVectorSize(len(fv)))
+}
+
 // HexFieldSize returns the size of a hex field.
 func HexFieldSize(v any) int {
 	switch v := v.(type) {
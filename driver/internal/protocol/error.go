@@ -2,6 +2,8 @@ package protocol
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 )
@@ -37,10 +39,44 @@ const (
 
 // HANA Database errors.
 const (
-	HdbErrAuthenticationFailed = 10
-	HdbErrWhileParsingProtocol = 1033
+	HdbErrAllocationFailed      = 4
+	HdbErrAuthenticationFailed  = 10
+	HdbErrTxRollbackInternal    = 129 // transaction rolled back by an internal error
+	HdbErrTxRollbackLockTimeout = 131 // transaction rolled back by lock wait timeout
+	HdbErrTxRollbackResource    = 132 // transaction rolled back due to unavailable resource
+	HdbErrTxRollbackDeadlock    = 133 // transaction rolled back by detected deadlock
+	HdbErrTxSerialization       = 138 // transaction serialization failure
+	HdbErrTxRollbackCancel      = 139 // current operation cancelled by request and transaction rolled back
+	HdbErrResourceBusyNoWait    = 146 // resource busy and NOWAIT specified
+	HdbErrInsufficientPrivilege = 258
+	HdbErrWhileParsingProtocol  = 1033
 )
 
+// HdbErrorDetails provides structured information extracted from the error text.
+// Fields are empty in case the information is not provided by the database server.
+type HdbErrorDetails struct {
+	Privilege string // missing privilege (insufficient privilege errors).
+	Object    string // object name the privilege is missing for (insufficient privilege errors).
+	GUID      string // guid to retrieve the details via procedure SYS.GET_INSUFFICIENT_PRIVILEGE_ERROR_DETAILS.
+}
+
+var (
+	reErrorGUID            = regexp.MustCompile(`(?i)\bguid\s+'([^']+)'`)
+	reErrorPrivilegeObject = regexp.MustCompile(`(?i)([a-z][a-z ]*?)\s+privilege\s+(?:on|for)\s+(?:object\s+)?(\S+)`)
+)
+
+func parseErrorDetails(text string) *HdbErrorDetails {
+	d := &HdbErrorDetails{}
+	if m := reErrorGUID.FindStringSubmatch(text); m != nil {
+		d.GUID = m[1]
+	}
+	if m := reErrorPrivilegeObject.FindStringSubmatch(text); m != nil {
+		d.Privilege = strings.ToUpper(strings.TrimSpace(m[1]))
+		d.Object = strings.TrimRight(m[2], ".,;")
+	}
+	return d
+}
+
 type sqlState [sqlStateSize]byte
 
 // HdbError represents a single error returned by the server.
@@ -88,6 +124,9 @@ func (e *HdbError) Level() int { return int(e.errorLevel) }
 // Text implements the driver.DBError interface.
 func (e *HdbError) Text() string { return string(e.errorText) }
 
+// Details implements the driver.DBError interface.
+func (e *HdbError) Details() *HdbErrorDetails { return parseErrorDetails(string(e.errorText)) }
+
 // IsWarning implements the driver.DBError interface.
 func (e *HdbError) IsWarning() bool { return e.errorLevel == errorLevelWarning }
 
@@ -97,6 +136,31 @@ func (e *HdbError) IsError() bool { return e.errorLevel == errorLevelError }
 // IsFatal implements the driver.DBError interface.
 func (e *HdbError) IsFatal() bool { return e.errorLevel == errorLevelFatalError }
 
+// IsTransactionRollback implements the driver.Error interface.
+func (e *HdbError) IsTransactionRollback() bool {
+	switch e.errorCode {
+	case HdbErrTxRollbackInternal, HdbErrTxRollbackLockTimeout, HdbErrTxRollbackResource, HdbErrTxRollbackDeadlock, HdbErrTxSerialization, HdbErrTxRollbackCancel:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsConnectionError implements the driver.Error interface.
+func (e *HdbError) IsConnectionError() bool {
+	return e.IsFatal() || strings.Contains(strings.ToLower(string(e.errorText)), "session not connected")
+}
+
+// IsRetriable implements the driver.Error interface.
+func (e *HdbError) IsRetriable() bool {
+	switch e.errorCode {
+	case HdbErrTxRollbackLockTimeout, HdbErrTxRollbackResource, HdbErrTxRollbackDeadlock, HdbErrTxSerialization, HdbErrResourceBusyNoWait:
+		return true
+	default:
+		return e.IsConnectionError()
+	}
+}
+
 // HdbErrors represent the collection of errors return by the server.
 type HdbErrors struct {
 	onlyWarnings bool
@@ -138,6 +202,44 @@ func (e *HdbErrors) Unwrap() []error {
 	return errs
 }
 
+// IsTransactionRollback implements the driver.Error interface.
+// In case of an error collection IsTransactionRollback returns true if any error reports a transaction rollback.
+func (e *HdbErrors) IsTransactionRollback() bool {
+	for _, err := range e.errs {
+		if err.IsTransactionRollback() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConnectionError implements the driver.Error interface.
+// In case of an error collection IsConnectionError returns true if any error reports a connection error.
+func (e *HdbErrors) IsConnectionError() bool {
+	for _, err := range e.errs {
+		if err.IsConnectionError() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetriable implements the driver.Error interface.
+// In case of an error collection IsRetriable returns true if all errors (ignoring warnings) are retriable.
+func (e *HdbErrors) IsRetriable() bool {
+	retriable := false
+	for _, err := range e.errs {
+		if err.IsWarning() {
+			continue
+		}
+		if !err.IsRetriable() {
+			return false
+		}
+		retriable = true
+	}
+	return retriable
+}
+
 // SetIdx implements the driver.Error interface.
 func (e *HdbErrors) SetIdx(idx int) {
 	if idx >= 0 && idx < len(e.errs) {
@@ -0,0 +1,83 @@
+package protocol
+
+import (
+	"testing"
+)
+
+func TestErrorDetails(t *testing.T) {
+	testData := []struct {
+		text    string
+		details HdbErrorDetails
+	}{
+		{"insufficient privilege: Not authorized", HdbErrorDetails{}},
+		{"insufficient privilege: Detailed info for this error can be found with guid '8B4E6B9C1D2F0A45B7E0C5A1B2C3D4E5'", HdbErrorDetails{GUID: "8B4E6B9C1D2F0A45B7E0C5A1B2C3D4E5"}},
+		{"insufficient privilege: SELECT privilege on object MYSCHEMA.MYTABLE not granted", HdbErrorDetails{Privilege: "SELECT", Object: "MYSCHEMA.MYTABLE"}},
+		{"insufficient privilege: catalog read privilege for SYS.USERS.", HdbErrorDetails{Privilege: "CATALOG READ", Object: "SYS.USERS"}},
+	}
+
+	for _, r := range testData {
+		details := parseErrorDetails(r.text)
+		if *details != r.details {
+			t.Fatalf("text %s: got details %v - expected %v", r.text, *details, r.details)
+		}
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	newError := func(code int32, level errorLevel, text string) *HdbError {
+		return &HdbError{errorCode: code, errorLevel: level, errorText: []byte(text)}
+	}
+	newErrors := func(errs ...*HdbError) *HdbErrors {
+		return &HdbErrors{errs: errs, HdbError: errs[0]}
+	}
+
+	var (
+		lockTimeout  = newError(HdbErrTxRollbackLockTimeout, errorLevelError, "transaction rolled back by lock wait timeout")
+		deadlock     = newError(HdbErrTxRollbackDeadlock, errorLevelError, "transaction rolled back by detected deadlock")
+		cancelled    = newError(HdbErrTxRollbackCancel, errorLevelError, "current operation cancelled by request and transaction rolled back")
+		duplicate    = newError(301, errorLevelError, "unique constraint violated")
+		notConnected = newError(-10807, errorLevelError, "Session not connected")
+		fatal        = newError(1, errorLevelFatalError, "general error")
+		warning      = newError(0, errorLevelWarning, "warning")
+		authFailed   = newError(HdbErrAuthenticationFailed, errorLevelError, "authentication failed")
+	)
+
+	tests := []struct {
+		name                                   string
+		err                                    *HdbErrors
+		retriable, txRollback, connectionError bool
+	}{
+		{"lockTimeout", newErrors(lockTimeout), true, true, false},
+		{"deadlock", newErrors(deadlock), true, true, false},
+		{"cancelled", newErrors(cancelled), false, true, false},
+		{"duplicate", newErrors(duplicate), false, false, false},
+		{"notConnected", newErrors(notConnected), true, false, true},
+		{"fatal", newErrors(fatal), true, false, true},
+		{"authentication", newErrors(authFailed), false, false, false},
+		// multiple errors
+		{"allRetriable", newErrors(lockTimeout, deadlock), true, true, false},
+		{"retriableAndWarning", newErrors(warning, deadlock), true, true, false},
+		{"onlyWarnings", newErrors(warning), false, false, false},
+		{"partlyRetriable", newErrors(duplicate, deadlock), false, true, false},
+		{"partlyConnection", newErrors(duplicate, notConnected), false, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if b := test.err.IsRetriable(); b != test.retriable {
+				t.Fatalf("retriable %t - expected %t", b, test.retriable)
+			}
+			if b := test.err.IsTransactionRollback(); b != test.txRollback {
+				t.Fatalf("transaction rollback %t - expected %t", b, test.txRollback)
+			}
+			if b := test.err.IsConnectionError(); b != test.connectionError {
+				t.Fatalf("connection error %t - expected %t", b, test.connectionError)
+			}
+			// classification does not depend on the selected error
+			test.err.SetIdx(len(test.err.errs) - 1)
+			if b := test.err.IsRetriable(); b != test.retriable {
+				t.Fatalf("retriable %t after SetIdx - expected %t", b, test.retriable)
+			}
+		})
+	}
+}
@@ -35,3 +35,39 @@ const (
 func (fc FunctionCode) IsProcedureCall() bool {
 	return fc == fcDBProcedureCall
 }
+
+// IsSelect returns true if the function code is a select statement (not selecting for update), false otherwise.
+func (fc FunctionCode) IsSelect() bool {
+	return fc == fcSelect
+}
+
+// StatementType returns the statement type of the function code (e.g. SELECT, INSERT, DDL) or an empty string
+// for function codes not representing a statement.
+func (fc FunctionCode) StatementType() string {
+	switch fc {
+	case FcDDL:
+		return "DDL"
+	case fcInsert:
+		return "INSERT"
+	case fcUpdate:
+		return "UPDATE"
+	case fcDelete:
+		return "DELETE"
+	case fcSelect:
+		return "SELECT"
+	case fcSelectForUpdate:
+		return "SELECT FOR UPDATE"
+	case fcExplain:
+		return "EXPLAIN"
+	case fcDBProcedureCall, fcDBProcedureCallWithResult:
+		return "CALL"
+	case fcCommit:
+		return "COMMIT"
+	case fcRollback:
+		return "ROLLBACK"
+	case fcSavepoint:
+		return "SAVEPOINT"
+	default:
+		return ""
+	}
+}
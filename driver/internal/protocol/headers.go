@@ -14,15 +14,27 @@ type messageHeader struct {
 	varPartLength uint32
 	varPartSize   uint32
 	noOfSegm      int16
+	packetOptions packetOptions
+	// compression (not negotiated by the driver)
+	compressionVarPartLength uint32
 }
 
+type packetOptions int8
+
+const (
+	poCompressed packetOptions = 0x02
+)
+
+func (o packetOptions) isCompressed() bool { return o&poCompressed != 0 }
+
 func (h *messageHeader) String() string {
-	return fmt.Sprintf("session id %d packetCount %d varPartLength %d, varPartSize %d noOfSegm %d",
+	return fmt.Sprintf("session id %d packetCount %d varPartLength %d, varPartSize %d noOfSegm %d compressed %t",
 		h.sessionID,
 		h.packetCount,
 		h.varPartLength,
 		h.varPartSize,
-		h.noOfSegm)
+		h.noOfSegm,
+		h.packetOptions.isCompressed())
 }
 
 func (h *messageHeader) encode(enc *encoding.Encoder) error {
@@ -41,7 +53,10 @@ func (h *messageHeader) decode(dec *encoding.Decoder) error {
 	h.varPartLength = dec.Uint32()
 	h.varPartSize = dec.Uint32()
 	h.noOfSegm = dec.Int16()
-	dec.Skip(10) // size: 32 bytes
+	h.packetOptions = packetOptions(dec.Int8())
+	dec.Skip(1) // reserved
+	h.compressionVarPartLength = dec.Uint32()
+	dec.Skip(4) // size: 32 bytes
 	return dec.Error()
 }
 
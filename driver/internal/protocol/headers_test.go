@@ -0,0 +1,43 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
+	"github.com/SAP/go-hdb/driver/unicode/cesu8"
+)
+
+func TestMessageHeaderCompressed(t *testing.T) {
+	tests := []struct {
+		name          string
+		packetOptions packetOptions
+		err           error
+	}{
+		{"uncompressed", 0, nil},
+		{"compressed", poCompressed, errCompressedMessage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			enc := encoding.NewEncoder(buf, cesu8.DefaultEncoder)
+			enc.Int64(1)  // session id
+			enc.Int32(0)  // packet count
+			enc.Uint32(0) // var part length
+			enc.Uint32(0) // var part size
+			enc.Int16(0)  // number of segments
+			enc.Int8(int8(tt.packetOptions))
+			enc.Zeroes(9)
+
+			r := newReader(encoding.NewDecoder(buf, cesu8.DefaultDecoder), false, slog.Default())
+			err := r.IterateParts(context.Background(), nil)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v - expected %v", err, tt.err)
+			}
+		})
+	}
+}
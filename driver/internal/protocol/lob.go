@@ -118,6 +118,9 @@ func (d *LobInDescr) setPos(pos int) { d.pos = pos }
 
 func (d *LobInDescr) size() int { return d.buf.Len() }
 
+// ChunkSize returns the size of the lob chunk fetched last.
+func (d *LobInDescr) ChunkSize() int { return d.size() }
+
 func (d *LobInDescr) writeFirst(enc *encoding.Encoder) { enc.Bytes(d.buf.Bytes()) }
 
 // LocatorID represents a locotor id.
@@ -127,6 +130,7 @@ type LocatorID uint64 // byte[locatorIdSize]
 type LobOutDescr struct {
 	decoder     func(descr *LobOutDescr, wr io.Writer) error
 	IsCharBased bool
+	IsClob      bool // byte based character lob (content is returned as stored)
 	/*
 		HDB does not return lob type code but undefined only
 		--> ltc is always ltcUndefined
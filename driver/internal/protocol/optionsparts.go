@@ -152,6 +152,18 @@ func (co *ConnectOptions) SetSelectForUpdateSupported(v bool) {
 	co.options.set(coSelectForUpdateSupported, v)
 }
 
+// SetColumnarResultSet sets the columnar result set option.
+func (co *ConnectOptions) SetColumnarResultSet(v bool) {
+	co.options.set(coColumnarResultSet, v)
+}
+
+// ColumnarResultSetOrZero returns the columnar result set option if available, the zero value otherwise.
+func (co *ConnectOptions) ColumnarResultSetOrZero() bool {
+	var v bool
+	co.options.get(coColumnarResultSet, &v)
+	return v
+}
+
 // DatabaseNameOrZero returns the database name option if available, the zero value otherwise.
 func (co *ConnectOptions) DatabaseNameOrZero() string {
 	var v string
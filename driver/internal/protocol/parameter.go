@@ -3,7 +3,9 @@ package protocol
 import (
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"reflect"
+	"time"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 	"golang.org/x/text/transform"
@@ -106,13 +108,149 @@ func (f *ParameterField) String() string {
 // IsLob returns true if the ParameterField is of type lob, false otherwise.
 func (f *ParameterField) IsLob() bool { return f.tc.isLob() }
 
+// IsBinary returns true if the ParameterField is of type binary or varbinary, false otherwise.
+func (f *ParameterField) IsBinary() bool { return f.tc == tcBinary || f.tc == tcVarbinary }
+
+// IsRealVector returns true if the ParameterField is of type real vector, false otherwise.
+func (f *ParameterField) IsRealVector() bool { return f.tc == tcRealVector }
+
+// ConvertOptions are the options of the parameter value conversion.
+type ConvertOptions struct {
+	// Lenient converts compatible numeric values as long as the conversion is lossless.
+	Lenient bool
+	// RoundDecimal rounds decimal values exceeding the field scale half-to-even instead of rejecting them
+	// (floating point values are rounded in any case).
+	RoundDecimal bool
+	// Location is the location of the wall clock time stored in date and timestamp fields (nil: UTC).
+	Location *time.Location
+}
+
 // Convert returns the result of the fieldType conversion.
-func (f *ParameterField) Convert(v any, t transform.Transformer) (any, error) {
+func (f *ParameterField) Convert(v any, t transform.Transformer, opts ConvertOptions) (any, error) {
+	if cv, ok := v.(ConvertedValue); ok {
+		if cv.tc == f.tc && !f.tc.isDecimalType() && !f.tc.isDateTime() { // already converted (decimals and dates depend on options)
+			return cv.v, nil
+		}
+		v = cv.v
+	}
+	if !f.tc.isKnown() { // fail fast instead of failing while encoding the parameter
+		return nil, f.convertError(v, errUnknownTypeCode)
+	}
 	cv, err := convertField(f.tc, v, t)
+	if err != nil && opts.Lenient {
+		cv, err = coerceField(f.tc, v)
+	}
+	if err != nil {
+		return nil, f.convertError(v, err)
+	}
+	if r, ok := cv.(*big.Rat); ok && f.tc.isDecimalType() {
+		if cv, err = checkDecimal(r, f.prec, f.scale, opts.RoundDecimal || isFloat(v)); err != nil {
+			return nil, f.convertError(v, err)
+		}
+	}
+	if err := f.checkDimension(cv); err != nil {
+		return nil, f.convertError(v, err)
+	}
+	if cv, err = f.wallClockTime(cv, opts.Location); err != nil {
+		return nil, f.convertError(v, err)
+	}
+	return f.truncateTime(cv), nil
+}
+
+// checkDimension checks if the dimension of a real vector value matches the dimension of the field
+// (a field dimension of zero does allow vectors of any dimension).
+func (f *ParameterField) checkDimension(v any) error {
+	fv, ok := v.([]float32)
+	if !ok || f.tc != tcRealVector || f.prec == 0 || len(fv) == int(f.prec) {
+		return nil
+	}
+	return fmt.Errorf("%w: dimension %d - expected %d", errDimensionMismatch, len(fv), f.prec)
+}
+
+/*
+ConvertColumn returns the result of the fieldType conversion of all values of column (slice) at once.
+The conversion is supported for the most common column types (e.g. []int64 for integer or []string for character fields)
+and returns false otherwise, in which case the values need to be converted one by one (see Convert).
+*/
+func (f *ParameterField) ConvertColumn(column any, opts ConvertOptions) ([]any, bool, error) {
+	values, row, ok, err := convertColumn(f.tc, column)
 	if err != nil {
-		return nil, fmt.Errorf("field %[1]s type code %[2]s type %[3]T value %[3]v coversion error %[4]w", f.fieldName(), f.tc, v, err)
+		return nil, true, fmt.Errorf("row %d: %w", row, f.convertError(reflect.ValueOf(column).Index(row).Interface(), err))
+	}
+	if opts.Location != nil && f.tc.isDateTime() {
+		for i, v := range values {
+			if values[i], err = f.wallClockTime(v, opts.Location); err != nil {
+				return nil, true, fmt.Errorf("row %d: %w", i, f.convertError(v, err))
+			}
+		}
+	}
+	if _, isTime := f.timePrecision(); isTime {
+		for i, v := range values {
+			values[i] = f.truncateTime(v)
+		}
+	}
+	return values, ok, nil
+}
+
+/*
+wallClockTime returns the time value v of date and timestamp fields as wall clock time in location loc.
+As the database date types are timezone-naive and the encoder stores the UTC wall clock time, the wall clock time
+in loc is returned as UTC time value. Time values of time fields are not affected.
+*/
+func (f *ParameterField) wallClockTime(v any, loc *time.Location) (any, error) {
+	t, ok := v.(time.Time)
+	if !ok || loc == nil || !f.tc.isDateTime() {
+		return v, nil
+	}
+	t = t.In(loc)
+	if year := t.Year(); year < minYear || year > maxYear {
+		return nil, errDateOutOfRange
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC), nil
+}
+
+/*
+timePrecision returns the precision of the fractional seconds stored by the database for time fields and
+false for all other field types.
+The precision is defined by the field type and limited by the field scale (declared precision) if provided.
+*/
+func (f *ParameterField) timePrecision() (time.Duration, bool) {
+	var digits int
+	switch f.tc {
+	case tcLongdate:
+		digits = 7 // 100 nanoseconds
+	case tcTimestamp, tcTime:
+		digits = 3 // milliseconds
+	case tcSeconddate, tcSecondtime:
+		digits = 0
+	default:
+		return 0, false
 	}
-	return cv, nil
+	if f.scale > 0 && f.scale < digits {
+		digits = f.scale
+	}
+	precision := time.Second
+	for i := 0; i < digits; i++ {
+		precision /= 10
+	}
+	return precision, true
+}
+
+// truncateTime truncates the fractional seconds of a time value to the precision of the field, so that
+// the stored value does not depend on how the database server does handle excess digits.
+func (f *ParameterField) truncateTime(v any) any {
+	t, ok := v.(time.Time)
+	if !ok {
+		return v
+	}
+	if precision, ok := f.timePrecision(); ok {
+		return t.Truncate(precision)
+	}
+	return v
+}
+
+func (f *ParameterField) convertError(v any, err error) error {
+	return fmt.Errorf("field %[1]s: cannot convert Go type %[2]T value %[2]v to HANA type %[3]s: %[4]w", f.fieldName(), v, f.tc.typeName(), err)
 }
 
 // TypeName returns the type name of the field.
@@ -157,6 +295,8 @@ func (f *ParameterField) InOut() bool { return f.mode == pmInout }
 // Name returns the parameter field name.
 func (f *ParameterField) Name() string { return f.fieldName() }
 
+func (f *ParameterField) fieldTypeCode() typeCode { return f.tc }
+
 func (f *ParameterField) decode(dec *encoding.Decoder) {
 	f.parameterOptions = parameterOptions(dec.Int8())
 	f.tc = typeCode(dec.Int8())
@@ -216,6 +356,8 @@ func (f *ParameterField) prmSize(v any) int {
 		return encoding.Cesu8FieldSize(v)
 	case tcStPoint, tcStGeometry:
 		return encoding.HexFieldSize(v)
+	case tcRealVector:
+		return encoding.RealVectorFieldSize(v)
 	case tcBlob, tcClob, tcLocator, tcNclob, tcText, tcNlocator, tcBintext:
 		return encoding.LobInputParametersSize
 	default:
@@ -273,6 +415,8 @@ func (f *ParameterField) encodePrm(enc *encoding.Encoder, v any) error {
 		return enc.Cesu8Field(v)
 	case tcStPoint, tcStGeometry:
 		return enc.HexField(v)
+	case tcRealVector:
+		return enc.RealVectorField(v)
 	case tcBlob, tcClob, tcLocator, tcNclob, tcText, tcNlocator, tcBintext:
 		descr, ok := v.(*LobInDescr)
 		if !ok {
@@ -333,6 +477,7 @@ func (m *ParameterMetadata) decodeNumArg(dec *encoding.Decoder, numArg int) erro
 type InputParameters struct {
 	InputFields []*ParameterField
 	nvargs      []driver.NamedValue
+	lobBytes    int
 }
 
 // NewInputParameters returns a InputParameters instance.
@@ -391,7 +536,11 @@ func (p *InputParameters) decodeNumArg(dec *encoding.Decoder, numArg int) error
 	return nil
 }
 
+// LobBytes returns the number of lob bytes (first lob data chunks) encoded with the input parameters.
+func (p *InputParameters) LobBytes() int { return p.lobBytes }
+
 func (p *InputParameters) encode(enc *encoding.Encoder) error {
+	p.lobBytes = 0
 	numColumns := len(p.InputFields)
 	if numColumns == 0 { // avoid divide-by-zero (e.g. prepare without parameters)
 		return nil
@@ -415,6 +564,7 @@ func (p *InputParameters) encode(enc *encoding.Encoder) error {
 			for j := 0; j < numColumns; j++ {
 				if lobInDescr, ok := p.nvargs[i*numColumns+j].Value.(*LobInDescr); ok {
 					lobInDescr.writeFirst(enc)
+					p.lobBytes += lobInDescr.size()
 				}
 			}
 		}
@@ -437,6 +587,12 @@ func (p *OutputParameters) decodeNumArg(dec *encoding.Decoder, numArg int) error
 	cols := len(p.OutputFields)
 	p.FieldValues = resizeSlice(p.FieldValues, numArg*cols)
 
+	if errs := unknownTypeErrors(dec, p.OutputFields, numArg); errs != nil {
+		clear(p.FieldValues)
+		p.DecodeErrors = append(p.DecodeErrors, errs...)
+		return nil
+	}
+
 	for i := 0; i < numArg; i++ {
 		for j, f := range p.OutputFields {
 			var err error
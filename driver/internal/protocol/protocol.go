@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"slices"
+	"sync/atomic"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 	"golang.org/x/text/transform"
@@ -27,6 +29,9 @@ const (
 	textSkip   = "*skipped"
 )
 
+// errCompressedMessage is returned when the database sends a compressed message, which the driver does not request.
+var errCompressedMessage = errors.New("compressed messages are not supported")
+
 // padding.
 const padding = 8
 
@@ -67,6 +72,17 @@ type Reader struct {
 	ph *partHeader
 
 	partCache partCache
+
+	recordParts bool
+	replyParts  []ReplyPart
+
+	warningHandler func(warning *HdbError)
+}
+
+// ReplyPart represents the kind and the number of arguments of a protocol reply part.
+type ReplyPart struct {
+	Kind   PartKind
+	NumArg int
 }
 
 func newReader(dec *encoding.Decoder, protTrace bool, logger *slog.Logger) *Reader {
@@ -103,6 +119,16 @@ func (r *Reader) SkipParts(ctx context.Context) error { return r.IterateParts(ct
 // SessionID returns the session ID.
 func (r *Reader) SessionID() int64 { return r.mh.sessionID }
 
+// SetRecordParts sets the record reply parts flag (see LastReplyParts).
+func (r *Reader) SetRecordParts(record bool) { r.recordParts = record }
+
+// SetWarningHandler sets the handler called for database server warnings. In case no handler is set
+// warnings are logged.
+func (r *Reader) SetWarningHandler(handler func(warning *HdbError)) { r.warningHandler = handler }
+
+// LastReplyParts returns the parts of the last reply read in case recording reply parts is set, nil otherwise.
+func (r *Reader) LastReplyParts() []ReplyPart { return slices.Clone(r.replyParts) }
+
 // FunctionCode returns the function code of the protocol.
 func (r *Reader) FunctionCode() FunctionCode { return r.sh.functionCode }
 
@@ -185,6 +211,14 @@ func (r *Reader) IterateParts(ctx context.Context, fn func(kind PartKind, attrs
 	if err := r.mh.decode(r.dec); err != nil {
 		return err
 	}
+	if r.mh.packetOptions.isCompressed() {
+		// compression is not requested by the driver - fail instead of reading compressed content (e.g. lob chunks) as raw bytes
+		return errCompressedMessage
+	}
+
+	if r.recordParts {
+		r.replyParts = r.replyParts[:0]
+	}
 
 	var numReadByte int64 = 0 // header bytes are not calculated in header varPartBytes: start with zero
 	if r.protTrace {
@@ -209,6 +243,10 @@ func (r *Reader) IterateParts(ctx context.Context, fn func(kind PartKind, attrs
 			}
 			kind := r.ph.partKind
 
+			if r.recordParts {
+				r.replyParts = append(r.replyParts, ReplyPart{Kind: kind, NumArg: r.ph.numArg()})
+			}
+
 			numReadByte += partHeaderSize
 
 			if r.protTrace {
@@ -286,7 +324,11 @@ func (r *Reader) IterateParts(ctx context.Context, fn func(kind PartKind, attrs
 	}
 	if lastErrors.onlyWarnings {
 		for _, err := range lastErrors.errs {
-			r.logger.LogAttrs(ctx, slog.LevelWarn, err.Error())
+			if r.warningHandler != nil {
+				r.warningHandler(err)
+			} else {
+				r.logger.LogAttrs(ctx, slog.LevelWarn, err.Error())
+			}
 		}
 		return nil
 	}
@@ -304,6 +346,8 @@ type Writer struct {
 	sv     map[string]string
 	svSent bool
 
+	numMessage atomic.Uint64 // number of written messages (database server round-trips)
+
 	// reuse header
 	mh *messageHeader
 	sh *segmentHeader
@@ -349,6 +393,25 @@ func (w *Writer) WriteProlog(ctx context.Context) error {
 	return w.wr.Flush()
 }
 
+type holdCursorCtxKey struct{}
+
+// WithHoldCursor returns a context with the hold cursor over commit command option set.
+func WithHoldCursor(ctx context.Context) context.Context {
+	return context.WithValue(ctx, holdCursorCtxKey{}, true)
+}
+
+// commandOptionsFromContext returns the command options of statement executions.
+func commandOptionsFromContext(ctx context.Context, messageType MessageType) commandOptions {
+	co := coNil
+	if messageType != MtExecuteDirect && messageType != MtExecute {
+		return co
+	}
+	if hold, ok := ctx.Value(holdCursorCtxKey{}).(bool); ok && hold {
+		co |= coHoldCursorOverCommtit
+	}
+	return co
+}
+
 func (w *Writer) _write(ctx context.Context, sessionID int64, messageType MessageType, commit bool, parts ...writablePart) error {
 	// check on session variables to be send as ClientInfo
 	if w.sv != nil && !w.svSent && messageType.ClientInfoSupported() {
@@ -390,6 +453,7 @@ func (w *Writer) _write(ctx context.Context, sessionID int64, messageType Messag
 
 	w.sh.messageType = messageType
 	w.sh.commit = commit
+	w.sh.commandOptions = commandOptionsFromContext(ctx, messageType)
 	w.sh.segmentKind = skRequest
 	w.sh.segmentLength = int32(size)
 	w.sh.segmentOfs = 0
@@ -441,5 +505,10 @@ func (w *Writer) Write(ctx context.Context, sessionID int64, messageType Message
 	if err := w._write(ctx, sessionID, messageType, commit, parts...); err != nil {
 		return errors.Join(err, driver.ErrBadConn)
 	}
+	w.numMessage.Add(1)
 	return nil
 }
+
+// NumMessage returns the number of messages written (each message is answered by a database server reply).
+// NumMessage is safe for concurrent use.
+func (w *Writer) NumMessage() uint64 { return w.numMessage.Load() }
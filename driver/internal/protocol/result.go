@@ -3,7 +3,9 @@ package protocol
 import (
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"reflect"
+	"time"
 
 	"github.com/SAP/go-hdb/driver/internal/protocol/encoding"
 )
@@ -105,6 +107,8 @@ func (f *ResultField) Nullable() bool { return f.columnOptions == coOptional }
 // Name returns the result field name.
 func (f *ResultField) Name() string { return f.names.name(f.columnDisplayNameOfs) }
 
+func (f *ResultField) fieldTypeCode() typeCode { return f.tc }
+
 func (f *ResultField) decode(dec *encoding.Decoder) {
 	f.columnOptions = columnOptions(dec.Int8())
 	f.tc = typeCode(dec.Int8())
@@ -123,7 +127,27 @@ func (f *ResultField) decode(dec *encoding.Decoder) {
 }
 
 func (f *ResultField) decodeResult(dec *encoding.Decoder) (any, error) {
-	return decodeResult(f.tc, dec, f.scale)
+	v, err := decodeResult(f.tc, dec, f.scale)
+	if v == nil && err == nil && dec.NullNumericAsZero() {
+		return nullZeroValue(f.tc.dataType()), nil
+	}
+	return v, err
+}
+
+// nullZeroValue returns the zero value of numeric and temporal data types and nil for all other data types.
+func nullZeroValue(dt DataType) any {
+	switch dt {
+	case DtTinyint, DtSmallint, DtInteger, DtBigint:
+		return int64(0)
+	case DtReal, DtDouble:
+		return float64(0)
+	case DtDecimal:
+		return new(big.Rat)
+	case DtTime:
+		return time.Time{}
+	default:
+		return nil
+	}
 }
 
 // ResultMetadata represents the metadata of a set of database result fields.
@@ -154,6 +178,8 @@ type Resultset struct {
 	ResultFields []*ResultField
 	FieldValues  []driver.Value
 	DecodeErrors DecodeErrors
+	// ValueBuffer is used to decode variable length field values if not nil (see encoding.Decoder.SetValueBuffer).
+	ValueBuffer []byte
 }
 
 func (r *Resultset) String() string {
@@ -164,13 +190,40 @@ func (r *Resultset) decodeNumArg(dec *encoding.Decoder, numArg int) error {
 	cols := len(r.ResultFields)
 	r.FieldValues = resizeSlice(r.FieldValues, numArg*cols)
 
-	for i := 0; i < numArg; i++ {
+	if errs := unknownTypeErrors(dec, r.ResultFields, numArg); errs != nil {
+		clear(r.FieldValues)
+		r.DecodeErrors = append(r.DecodeErrors, errs...)
+		return nil
+	}
+
+	if r.ValueBuffer != nil {
+		dec.SetValueBuffer(r.ValueBuffer)
+		defer func() {
+			r.ValueBuffer = dec.ValueBuffer()
+			dec.SetValueBuffer(nil)
+		}()
+	}
+
+	if dec.ColumnarResultSet() { // column wise result passing
 		for j, f := range r.ResultFields {
-			var err error
-			if r.FieldValues[i*cols+j], err = f.decodeResult(dec); err != nil {
-				r.DecodeErrors = append(r.DecodeErrors, &DecodeError{row: i, fieldName: f.Name(), s: err.Error()}) // collect decode / conversion errors
+			for i := 0; i < numArg; i++ {
+				r.decodeField(dec, f, i, i*cols+j)
 			}
 		}
+		return dec.Error()
+	}
+
+	for i := 0; i < numArg; i++ {
+		for j, f := range r.ResultFields {
+			r.decodeField(dec, f, i, i*cols+j)
+		}
 	}
 	return dec.Error()
 }
+
+func (r *Resultset) decodeField(dec *encoding.Decoder, f *ResultField, row, idx int) {
+	var err error
+	if r.FieldValues[idx], err = f.decodeResult(dec); err != nil {
+		r.DecodeErrors = append(r.DecodeErrors, &DecodeError{row: row, fieldName: f.Name(), s: err.Error()}) // collect decode / conversion errors
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 // rows affected.
 const (
+	RaUnknown         = -1 // number of affected rows is unknown (see RowCounts)
 	raSuccessNoInfo   = -2
 	RaExecutionFailed = -3
 )
@@ -41,3 +42,17 @@ func (r RowsAffected) Total() int64 {
 	}
 	return total
 }
+
+// RowCounts returns the number of affected rows per row (batch element) where rows executed
+// successfully without information about the number of affected rows are reported as RaUnknown.
+func (r RowsAffected) RowCounts() []int64 {
+	counts := make([]int64, len(r.rows))
+	for i, rows := range r.rows {
+		if rows == raSuccessNoInfo {
+			counts[i] = RaUnknown
+		} else {
+			counts[i] = int64(rows)
+		}
+	}
+	return counts
+}
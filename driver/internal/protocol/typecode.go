@@ -73,6 +73,7 @@ const (
 	tcFixed8            typeCode = 0x51
 	tcFixed12           typeCode = 0x52
 	tcCiphertext        typeCode = 0x5A
+	tcRealVector        typeCode = 0x60
 
 	// special null values.
 	tcSecondtimeNull typeCode = 0xB0
@@ -87,13 +88,17 @@ func (tc typeCode) isLob() bool {
 }
 
 func (tc typeCode) isVariableLength() bool {
-	return tc == tcChar || tc == tcNchar || tc == tcVarchar || tc == tcNvarchar || tc == tcBinary || tc == tcVarbinary || tc == tcShorttext || tc == tcAlphanum
+	return tc == tcChar || tc == tcNchar || tc == tcVarchar || tc == tcNvarchar || tc == tcBinary || tc == tcVarbinary || tc == tcShorttext || tc == tcAlphanum || tc == tcRealVector
 }
 
 func (tc typeCode) isDecimalType() bool {
 	return tc == tcSmalldecimal || tc == tcDecimal || tc == tcFixed8 || tc == tcFixed12 || tc == tcFixed16
 }
 
+func (tc typeCode) isDateTime() bool {
+	return tc == tcDate || tc == tcTimestamp || tc == tcLongdate || tc == tcSeconddate || tc == tcDaydate
+}
+
 func (tc typeCode) supportNullValue() bool {
 	// boolean values: false =:= 0; null =:= 1; true =:= 2
 	return !(tc == tcBoolean)
@@ -163,15 +168,26 @@ func (tc typeCode) dataType() DataType {
 		return DtBytes
 	case tcBlob, tcClob, tcNclob, tcText, tcBintext:
 		return DtLob
+	case tcRealVector:
+		return DtRealVector
 	case TcTableRows:
 		return DtRows
 	default:
+		if !tc.isKnown() { // unknown type codes are returned as bytes (see Decoder.UnknownTypeAsBytes)
+			return DtBytes
+		}
 		panic(fmt.Sprintf("missing DataType for typeCode %s", tc))
 	}
 }
 
+// isKnown returns true if the TypeCode is known by the driver, false otherwise.
+func (tc typeCode) isKnown() bool { return !strings.HasPrefix(tc.String(), "typeCode(") }
+
 // typeName returns the database type name.
 // see https://golang.org/pkg/database/sql/driver/#RowsColumnTypeDatabaseTypeName
 func (tc typeCode) typeName() string {
+	if !tc.isKnown() {
+		return fmt.Sprintf("UNKNOWN(%d)", tc)
+	}
 	return strings.ToUpper(tc.String()[2:])
 }
@@ -70,21 +70,23 @@ func _() {
 	_ = x[tcFixed8-81]
 	_ = x[tcFixed12-82]
 	_ = x[tcCiphertext-90]
+	_ = x[tcRealVector-96]
 	_ = x[tcSecondtimeNull-176]
 	_ = x[TcTableRows-127]
 }
 
 const (
-	_typeCode_name_0 = "tcNulltcTinyinttcSmallinttcIntegertcBiginttcDecimaltcRealtcDoubletcChartcVarchartcNchartcNvarchartcBinarytcVarbinarytcDatetcTimetcTimestamptcTimetztcTimeltztcTimestampTztcTimestampLtztcIntervalYmtcIntervalDstcRowidtcUrowidtcClobtcNclobtcBlobtcBooleantcStringtcNstringtcLocatortcNlocatortcBstringtcDecimalDigitArraytcVarchar2"
-	_typeCode_name_1 = "tcTable"
-	_typeCode_name_2 = "tcSmalldecimaltcAbapstreamtcAbapstructtcAarraytcTexttcShorttexttcBintext"
-	_typeCode_name_3 = "tcAlphanum"
-	_typeCode_name_4 = "tcLongdatetcSeconddatetcDaydatetcSecondtime"
-	_typeCode_name_5 = "tcClocatortcBlobDiskReservedtcClobDiskReservedtcNclobDiskReservedtcStGeometrytcStPointtcFixed16tcAbapItabtcRecordRowStoretcRecordColumnStore"
-	_typeCode_name_6 = "tcFixed8tcFixed12"
-	_typeCode_name_7 = "tcCiphertext"
-	_typeCode_name_8 = "TcTableRows"
-	_typeCode_name_9 = "tcSecondtimeNull"
+	_typeCode_name_0  = "tcNulltcTinyinttcSmallinttcIntegertcBiginttcDecimaltcRealtcDoubletcChartcVarchartcNchartcNvarchartcBinarytcVarbinarytcDatetcTimetcTimestamptcTimetztcTimeltztcTimestampTztcTimestampLtztcIntervalYmtcIntervalDstcRowidtcUrowidtcClobtcNclobtcBlobtcBooleantcStringtcNstringtcLocatortcNlocatortcBstringtcDecimalDigitArraytcVarchar2"
+	_typeCode_name_1  = "tcTable"
+	_typeCode_name_2  = "tcSmalldecimaltcAbapstreamtcAbapstructtcAarraytcTexttcShorttexttcBintext"
+	_typeCode_name_3  = "tcAlphanum"
+	_typeCode_name_4  = "tcLongdatetcSeconddatetcDaydatetcSecondtime"
+	_typeCode_name_5  = "tcClocatortcBlobDiskReservedtcClobDiskReservedtcNclobDiskReservedtcStGeometrytcStPointtcFixed16tcAbapItabtcRecordRowStoretcRecordColumnStore"
+	_typeCode_name_6  = "tcFixed8tcFixed12"
+	_typeCode_name_7  = "tcCiphertext"
+	_typeCode_name_8  = "tcRealVector"
+	_typeCode_name_9  = "TcTableRows"
+	_typeCode_name_10 = "tcSecondtimeNull"
 )
 
 var (
@@ -117,10 +119,12 @@ func (i typeCode) String() string {
 		return _typeCode_name_6[_typeCode_index_6[i]:_typeCode_index_6[i+1]]
 	case i == 90:
 		return _typeCode_name_7
-	case i == 127:
+	case i == 96:
 		return _typeCode_name_8
-	case i == 176:
+	case i == 127:
 		return _typeCode_name_9
+	case i == 176:
+		return _typeCode_name_10
 	default:
 		return "typeCode(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
@@ -335,11 +339,12 @@ func _() {
 	_ = x[DtBytes-11]
 	_ = x[DtLob-12]
 	_ = x[DtRows-13]
+	_ = x[DtRealVector-14]
 }
 
-const _DataType_name = "DtUnknownDtBooleanDtTinyintDtSmallintDtIntegerDtBigintDtRealDtDoubleDtDecimalDtTimeDtStringDtBytesDtLobDtRows"
+const _DataType_name = "DtUnknownDtBooleanDtTinyintDtSmallintDtIntegerDtBigintDtRealDtDoubleDtDecimalDtTimeDtStringDtBytesDtLobDtRowsDtRealVector"
 
-var _DataType_index = [...]uint8{0, 9, 18, 27, 37, 46, 54, 60, 68, 77, 83, 91, 98, 103, 109}
+var _DataType_index = [...]uint8{0, 9, 18, 27, 37, 46, 54, 60, 68, 77, 83, 91, 98, 103, 109, 121}
 
 func (i DataType) String() string {
 	if i >= DataType(len(_DataType_index)-1) {
@@ -0,0 +1,70 @@
+//go:build go1.23
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"iter"
+)
+
+/*
+Rows executes the query with arguments args on connection sqlConn and returns an iterator
+over the rows of the query result set:
+
+	for values, err := range driver.Rows(ctx, conn, "select * from dummy") {
+		if err != nil {
+			// handle error
+		}
+		// process values
+	}
+
+To avoid allocations the values slice is reused for all rows. Additionally the variable length values
+(e.g. character and binary values returned as []byte) of all rows fetched after the first fetch are
+decoded into a buffer, which is reused for each fetch. This avoids a per value allocation for wide result sets,
+but the values are only valid until the next iteration and need to be copied to be retained.
+The result set is closed when the iteration ends, including an early exit of the range loop.
+In case of an error the error is returned as last iteration value.
+
+Please note that the connection is exclusively used by the iterator for the duration of the
+range loop, so sqlConn must not be used within the loop.
+*/
+func Rows(ctx context.Context, sqlConn *sql.Conn, query string, args ...any) iter.Seq2[[]driver.Value, error] {
+	return func(yield func([]driver.Value, error) bool) {
+		if err := rawConn(sqlConn, func(c *conn) error {
+			rows, closeStmt, err := c.queryRows(ctx, query, args)
+			if err != nil {
+				return err
+			}
+			defer closeStmt()
+			return yieldRows(rows, yield)
+		}); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// yieldRows yields the values of rows until the rows are exhausted or yield returns false.
+// The rows are closed in any case.
+func yieldRows(rows driver.Rows, yield func([]driver.Value, error) bool) error {
+	defer rows.Close()
+
+	if qr, ok := rows.(*queryResult); ok {
+		qr.reuseValueBuffer()
+	}
+	values := make([]driver.Value, len(rows.Columns()))
+	for {
+		if err := rows.Next(values); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if !yield(values, nil) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,70 @@
+//go:build go1.23
+
+package driver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+type testIterRows struct {
+	numRow, row int
+	closed      bool
+}
+
+func (r *testIterRows) Columns() []string { return []string{"A", "B"} }
+func (r *testIterRows) Close() error      { r.closed = true; return nil }
+func (r *testIterRows) Next(dest []driver.Value) error {
+	if r.closed {
+		return errors.New("rows closed")
+	}
+	if r.row == r.numRow {
+		return io.EOF
+	}
+	r.row++
+	dest[0], dest[1] = int64(r.row), "row"
+	return nil
+}
+
+func TestYieldRows(t *testing.T) {
+	const numRow = 5
+
+	t.Run("full", func(t *testing.T) {
+		rows := &testIterRows{numRow: numRow}
+		cnt := 0
+		if err := yieldRows(rows, func(values []driver.Value, err error) bool {
+			cnt++
+			if values[0] != int64(cnt) || values[1] != "row" {
+				t.Fatalf("invalid values %v", values)
+			}
+			return true
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if cnt != numRow {
+			t.Fatalf("number of rows %d - expected %d", cnt, numRow)
+		}
+		if !rows.closed {
+			t.Fatal("rows not closed")
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		rows := &testIterRows{numRow: numRow}
+		cnt := 0
+		if err := yieldRows(rows, func(values []driver.Value, err error) bool {
+			cnt++
+			return cnt < 2
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if cnt != 2 {
+			t.Fatalf("number of rows %d - expected %d", cnt, 2)
+		}
+		if !rows.closed {
+			t.Fatal("rows not closed")
+		}
+	})
+}
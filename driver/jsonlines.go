@@ -0,0 +1,167 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// jsonField is a scan destination encoding a database field value as JSON.
+type jsonField struct {
+	binary bool   // encode bytes as base64 string
+	b      []byte // JSON encoded value
+}
+
+// isBinaryTypeName returns true if the database type name is a binary data type.
+func isBinaryTypeName(typeName string) bool {
+	switch typeName {
+	case "BINARY", "VARBINARY", "BLOB", "LOCATOR":
+		return true
+	default:
+		return false
+	}
+}
+
+// exactDecimalString returns the exact decimal representation of r.
+func exactDecimalString(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	// decimal values are finite: scale until integer to get the number of fractional digits
+	x := new(big.Rat).Set(r)
+	ten := big.NewRat(10, 1)
+	n := 0
+	for ; !x.IsInt(); n++ {
+		x.Mul(x, ten)
+	}
+	return r.FloatString(n)
+}
+
+func (f *jsonField) appendBytes(b []byte) error {
+	var (
+		jb  []byte
+		err error
+	)
+	if f.binary {
+		jb, err = json.Marshal(b) // base64
+	} else {
+		jb, err = json.Marshal(string(b))
+	}
+	if err != nil {
+		return err
+	}
+	f.b = append(f.b, jb...)
+	return nil
+}
+
+// Scan implements the database/sql/Scanner interface.
+func (f *jsonField) Scan(src any) error {
+	f.b = f.b[:0]
+	switch src := src.(type) {
+	case nil:
+		f.b = append(f.b, "null"...)
+	case bool:
+		f.b = strconv.AppendBool(f.b, src)
+	case int64:
+		f.b = strconv.AppendInt(f.b, src, 10)
+	case float64:
+		b, err := json.Marshal(src)
+		if err != nil {
+			return err
+		}
+		f.b = append(f.b, b...)
+	case *big.Rat:
+		f.b = append(f.b, exactDecimalString(src)...)
+	case time.Time:
+		f.b = append(f.b, '"')
+		f.b = src.UTC().AppendFormat(f.b, time.RFC3339Nano)
+		f.b = append(f.b, '"')
+	case string:
+		b, err := json.Marshal(src)
+		if err != nil {
+			return err
+		}
+		f.b = append(f.b, b...)
+	case []byte:
+		return f.appendBytes(src)
+	case p.LobScanner:
+		wr := new(bytes.Buffer)
+		if err := scanLob(src, wr); err != nil {
+			return err
+		}
+		return f.appendBytes(wr.Bytes())
+	default:
+		return fmt.Errorf("json: invalid data type %T", src)
+	}
+	return nil
+}
+
+/*
+ExportJSONLines executes query and writes each result row as JSON object keyed by column name to w, one object per line (NDJSON).
+
+The JSON value types are chosen by the database column type:
+  - numeric columns are encoded as JSON numbers (decimals in exact representation)
+  - boolean columns are encoded as JSON booleans
+  - date and time columns are encoded as RFC3339 strings (UTC)
+  - binary columns are encoded as base64 strings
+  - all other columns are encoded as JSON strings
+  - NULL values are encoded as JSON null
+*/
+func ExportJSONLines(ctx context.Context, conn *sql.Conn, query string, w io.Writer) error {
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	keys := make([][]byte, len(columnTypes))
+	fields := make([]*jsonField, len(columnTypes))
+	scanArgs := make([]any, len(columnTypes))
+	for i, columnType := range columnTypes {
+		if keys[i], err = json.Marshal(columnType.Name()); err != nil {
+			return err
+		}
+		fields[i] = &jsonField{binary: isBinaryTypeName(columnType.DatabaseTypeName())}
+		scanArgs[i] = fields[i]
+	}
+
+	var line []byte
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := rows.Scan(scanArgs...); err != nil {
+			return err
+		}
+		line = append(line[:0], '{')
+		for i, field := range fields {
+			if i > 0 {
+				line = append(line, ',')
+			}
+			line = append(line, keys[i]...)
+			line = append(line, ':')
+			line = append(line, field.b...)
+		}
+		line = append(line, '}', '\n')
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return rows.Close()
+}
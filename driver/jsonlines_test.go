@@ -0,0 +1,98 @@
+//go:build !unit
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestExportJSONLines(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	tableName := RandomIdentifier("jsonLines_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, d decimal(10,3), s nvarchar(20), b varbinary(10), t timestamp, f double, z boolean)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?, ?, ?, ?, ?, ?)", tableName), 1, "12.5", `say "hi"`, []byte("go"), time.Date(2024, time.February, 29, 12, 30, 15, 0, time.UTC), 1.5, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (2, null, null, null, null, null, null)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	buf := new(bytes.Buffer)
+	if err := ExportJSONLines(ctx, conn, fmt.Sprintf("select * from %s order by i", tableName), buf); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"I":1,"D":12.5,"S":"say \"hi\"","B":"Z28=","T":"2024-02-29T12:30:15Z","F":1.5,"Z":true}
+{"I":2,"D":null,"S":null,"B":null,"T":null,"F":null,"Z":null}
+`
+	if buf.String() != expected {
+		t.Fatalf("got\n%s\nexpected\n%s", buf.String(), expected)
+	}
+}
+
+func TestJSONFieldScan(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		src  any
+		json string
+	}{
+		{nil, `null`},
+		{int64(-42), `-42`},
+		{`say "hi"`, `"say \"hi\""`},
+		{"a\tb\n", `"a\tb\n"`},
+		{[]byte(`say "hi"`), `"say \"hi\""`},
+	}
+
+	for _, d := range testData {
+		var f jsonField
+		if err := f.Scan(d.src); err != nil {
+			t.Fatal(err)
+		}
+		if string(f.b) != d.json {
+			t.Fatalf("got %s - expected %s", f.b, d.json)
+		}
+	}
+}
+
+func TestExactDecimalString(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		decimal string
+		s       string
+	}{
+		{"0", "0"},
+		{"-42", "-42"},
+		{"12.500", "12.5"},
+		{"0.0009765625", "0.0009765625"},
+		{"-1e-20", "-0.00000000000000000001"},
+	}
+
+	for _, d := range testData {
+		r, ok := new(big.Rat).SetString(d.decimal)
+		if !ok {
+			t.Fatalf("invalid decimal %s", d.decimal)
+		}
+		if s := exactDecimalString(r); s != d.s {
+			t.Fatalf("decimal %s: got %s - expected %s", d.decimal, s, d.s)
+		}
+	}
+}
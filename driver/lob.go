@@ -70,9 +70,15 @@ func ScanLobWriter(src any, wr io.Writer) error {
 // A Lob object uses an io.Writer object as destination for reading content from a database lob field.
 // A Lob can be created by contructor method NewLob with io.Reader and io.Writer as parameters or
 // created by new, setting io.Reader and io.Writer by SetReader and SetWriter methods.
+//
+// The content of the io.Reader is streamed to the database server in chunks of size lobChunkSize (see Connector),
+// so that arbitrarily large lobs can be written without buffering the whole content in memory.
+// The streaming is bound to the context of the exec call and stops on cancellation - in case of an error
+// a LobWriteError reports the number of bytes written.
 type Lob struct {
-	rd io.Reader
-	wr io.Writer
+	rd      io.Reader
+	wr      io.Writer
+	scanner p.LobScanner // database content not read yet (see WriteTo)
 }
 
 // NewLob creates a new Lob instance with the io.Reader and io.Writer given as parameters.
@@ -105,11 +111,76 @@ func (l *Lob) SetWriter(wr io.Writer) *Lob {
 }
 
 // Scan implements the database/sql/Scanner interface.
+// The content is read into the Lob writer. If no writer is set, the content is not read by Scan
+// but streamed by WriteTo.
 func (l *Lob) Scan(src any) error {
-	if l.wr == nil {
-		l.wr = new(bytes.Buffer)
+	l.scanner = nil
+	if l.wr != nil {
+		return ScanLobWriter(src, l.wr)
 	}
-	return ScanLobWriter(src, l.wr)
+	scanner, ok := src.(p.LobScanner)
+	if !ok {
+		return fmt.Errorf("lob: invalid scan type %T", src)
+	}
+	l.scanner = scanner
+	return nil
+}
+
+// check if Lob implements all required interfaces.
+var _ io.WriterTo = (*Lob)(nil)
+
+/*
+WriteTo implements the io.WriterTo interface.
+If no writer was set before scanning, WriteTo streams the content from the database to w chunk by chunk
+(see lobChunkSize), so that
+
+	rows, err := db.Query("select blob from t")
+	...
+	for rows.Next() {
+		var lob driver.Lob
+		if err := rows.Scan(&lob); err != nil {
+			// handle error
+		}
+		n, err := lob.WriteTo(file)
+		...
+	}
+
+copies the Lob content to file without keeping it in memory. As the content is read via the lob locator of
+the result, WriteTo needs to be called before the rows are closed.
+Otherwise the content is copied from the Lob writer, which needs to implement the io.WriterTo or the io.Reader
+interface (e.g. bytes.Buffer).
+In both cases the content is consumed by WriteTo.
+*/
+func (l *Lob) WriteTo(w io.Writer) (int64, error) {
+	if l.scanner != nil {
+		scanner := l.scanner
+		l.scanner = nil
+		cw := &countWriter{w: w}
+		err := scanLob(scanner, cw)
+		return cw.n, err
+	}
+	switch wr := l.wr.(type) {
+	case nil:
+		return 0, nil
+	case io.WriterTo:
+		return wr.WriteTo(w)
+	case io.Reader:
+		return io.Copy(w, wr)
+	default:
+		return 0, fmt.Errorf("lob: writer %T does not support copying content", l.wr)
+	}
+}
+
+// countWriter counts the number of bytes written to w.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
 }
 
 // NullLob represents an Lob that may be null.
@@ -127,6 +198,9 @@ func (n *NullLob) Scan(value any) error {
 		return nil
 	}
 	n.Valid = true
+	if n.Lob == nil {
+		n.Lob = new(Lob)
+	}
 	return n.Lob.Scan(value)
 }
 
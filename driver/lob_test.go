@@ -10,10 +10,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/SAP/go-hdb/driver/internal/rand/alphanum"
+	"golang.org/x/text/encoding/charmap"
 )
 
 type stringLob string
@@ -247,6 +249,470 @@ func testLobDelayedScan(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testLobMaxOpenLobs(t *testing.T, db *sql.DB) {
+	const (
+		numRec  = 2
+		lobSize = 100000 // lob content is not provided completely by result set
+	)
+
+	table := RandomIdentifier("lobMaxOpen_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, b1 blob, b2 blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := tx.Prepare(fmt.Sprintf("insert into %s values (?,?,?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	for i := 0; i < numRec; i++ {
+		if _, err := stmt.Exec(i, NewLob(io.LimitReader(randReader{}, lobSize), nil), NewLob(io.LimitReader(randReader{}, lobSize), nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	connector := MT.NewConnector()
+	connector.SetMaxOpenLobs(3)
+	maxDB := sql.OpenDB(connector)
+	defer maxDB.Close()
+
+	query := fmt.Sprintf("select * from %s order by i", table)
+
+	// lobs are read while scanning -> limit is not exceeded
+	rows, err := maxDB.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		i      int
+		b1, b2 bytesLob
+	)
+	for rows.Next() {
+		if err := rows.Scan(&i, &b1, &b2); err != nil {
+			t.Fatal(err)
+		}
+		if len(b1) != lobSize || len(b2) != lobSize {
+			t.Fatalf("got lob sizes %d %d - expected %d", len(b1), len(b2), lobSize)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// lobs are not read -> limit is exceeded fetching the second row
+	rows, err = maxDB.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var v1, v2 any
+	for rows.Next() {
+		if err := rows.Scan(&i, &v1, &v2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rows.Err(); !errors.Is(err, errMaxOpenLobs) {
+		t.Fatalf("got error %v - expected %s", err, errMaxOpenLobs)
+	}
+
+	// no limit -> unread lobs are not registered
+	ctx := context.Background()
+	sqlConn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlConn.Close()
+	unlimitedRows, err := sqlConn.QueryContext(ctx, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlimitedRows.Close()
+	for unlimitedRows.Next() {
+		if err := unlimitedRows.Scan(&i, &v1, &v2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := unlimitedRows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rawConn(sqlConn, func(c *conn) error {
+		if len(c.openLobs) != 0 {
+			return fmt.Errorf("got %d registered lobs - expected 0", len(c.openLobs))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testLobEmpty(t *testing.T, db *sql.DB) {
+	table := RandomIdentifier("lobEmpty_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, b blob, n nclob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	testData := []struct {
+		b, n  any
+		valid bool
+	}{
+		{[]byte{}, "", true},
+		{NewLob(bytes.NewReader(nil), nil), NewLob(bytes.NewReader(nil), nil), true},
+		{new(Lob), new(Lob), true}, // no reader: empty lob
+		{nil, nil, false},
+		{NullLob{}, NullLob{}, false},
+		{(*Lob)(nil), (*Lob)(nil), false},
+	}
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := tx.Prepare(fmt.Sprintf("insert into %s values (?,?,?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	for i, r := range testData {
+		if _, err := stmt.Exec(i, r.b, r.n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select * from %s order by i", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var (
+		i    int
+		b, n NullLob
+	)
+	for rows.Next() {
+		if err := rows.Scan(&i, &b, &n); err != nil {
+			t.Fatal(err)
+		}
+		valid := testData[i].valid
+		if b.Valid != valid || n.Valid != valid {
+			t.Fatalf("idx %d got valid %t %t - expected %t", i, b.Valid, n.Valid, valid)
+		}
+		if !valid {
+			continue
+		}
+		if l, err := b.Lob.WriteTo(io.Discard); err != nil || l != 0 {
+			t.Fatalf("idx %d got blob size %d error %v - expected 0", i, l, err)
+		}
+		if l, err := n.Lob.WriteTo(io.Discard); err != nil || l != 0 {
+			t.Fatalf("idx %d got nclob size %d error %v - expected 0", i, l, err)
+		}
+		b, n = NullLob{}, NullLob{}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testLobEncoding(t *testing.T, db *sql.DB) {
+	table := RandomIdentifier("lobEncoding_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (c clob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	const s = "äöüÄÖÜß"
+	latin1, err := charmap.ISO8859_1.NewEncoder().String(strings.Repeat(s, 1000)) // exceed lob chunk size
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values (?)", table), []byte(latin1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	query := fmt.Sprintf("select c from %s", table)
+
+	// default: content as stored
+	var b bytesLob
+	if err := db.QueryRow(query).Scan(&b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != latin1 {
+		t.Fatal("invalid clob content")
+	}
+
+	// explicit encoding
+	ctx := WithLobEncoding(context.Background(), charmap.ISO8859_1)
+	var str stringLob
+	if err := db.QueryRowContext(ctx, query).Scan(&str); err != nil {
+		t.Fatal(err)
+	}
+	if string(str) != strings.Repeat(s, 1000) {
+		t.Fatalf("invalid clob content %s", str[:min(len(str), 100)])
+	}
+}
+
+func testLobWriteTo(t *testing.T, db *sql.DB) {
+	table := RandomIdentifier("lobWriteTo_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (b blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	content := bytes.Repeat([]byte("go-hdb lob "), 10000) // exceed lob chunk size
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values (?)", table), NewLob(bytes.NewReader(content), nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	query := fmt.Sprintf("select b from %s", table)
+
+	// content is streamed from the database by WriteTo
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatalf("no rows: %v", rows.Err())
+	}
+	var lob Lob
+	if err := rows.Scan(&lob); err != nil {
+		t.Fatal(err)
+	}
+	if lob.Writer() != nil {
+		t.Fatalf("got writer %T - expected content not to be read by scan", lob.Writer())
+	}
+	buf := new(bytes.Buffer)
+	n, err := lob.WriteTo(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(content)) || !bytes.Equal(buf.Bytes(), content) {
+		t.Fatalf("copied %d bytes - expected %d", n, len(content))
+	}
+	// content is consumed
+	if n, err = lob.WriteTo(buf); err != nil || n != 0 {
+		t.Fatalf("copied %d bytes error %v - expected 0 bytes", n, err)
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// content is read into the writer by scan
+	lob.SetWriter(new(bytes.Buffer))
+	if err := db.QueryRow(query).Scan(&lob); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if n, err = lob.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(content)) || !bytes.Equal(buf.Bytes(), content) {
+		t.Fatalf("copied %d bytes - expected %d", n, len(content))
+	}
+}
+
+// cancelReader provides endless content and cancels the context after limit bytes are read.
+type cancelReader struct {
+	cancel context.CancelFunc
+	limit  int
+	n      int
+}
+
+func (r *cancelReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'x'
+	}
+	r.n += len(p)
+	if r.n >= r.limit {
+		r.cancel()
+	}
+	return len(p), nil
+}
+
+func testLobStreamCancel(t *testing.T, db *sql.DB) {
+	table := RandomIdentifier("lobStreamCancel_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (b blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	rd := &cancelReader{cancel: cancel, limit: 10 * defaultLobChunkSize}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("insert into %s values (?)", table), NewLob(rd, nil))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v - expected %v", err, context.Canceled)
+	}
+	var lobWriteError *LobWriteError
+	if errors.As(err, &lobWriteError) && lobWriteError.Written > int64(rd.n) {
+		t.Fatalf("written %d bytes - more than read %d", lobWriteError.Written, rd.n)
+	}
+
+	// connection pool is still usable
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// cancelWriter cancels the context after limit bytes are written.
+type cancelWriter struct {
+	cancel context.CancelFunc
+	limit  int
+	n      int
+}
+
+func (w *cancelWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	if w.n >= w.limit {
+		w.cancel()
+	}
+	return len(p), nil
+}
+
+func testLobScanCancel(t *testing.T, db *sql.DB) {
+	const lobSize = 20 * defaultLobChunkSize
+
+	table := RandomIdentifier("lobScanCancel_")
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (b blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values (?)", table), NewLob(io.LimitReader(randReader{}, lobSize), nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wr := &cancelWriter{cancel: cancel, limit: 2 * defaultLobChunkSize}
+	err = db.QueryRowContext(ctx, fmt.Sprintf("select b from %s", table)).Scan(NewLob(nil, wr))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v - expected %v", err, context.Canceled)
+	}
+	if wr.n >= lobSize {
+		t.Fatalf("read %d bytes - expected lob read to be stopped before reading %d bytes", wr.n, lobSize)
+	}
+
+	// connection pool is still usable
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testLobChunkSize(t *testing.T, db *sql.DB) {
+	// fallback and limits
+	ctx := context.Background()
+	for _, test := range []struct{ size, expected int }{
+		{0, defaultLobChunkSize},
+		{-1, defaultLobChunkSize},
+		{1, minLobChunkSize},
+		{1000, 1000},
+	} {
+		if size := lobChunkSize(WithLobChunkSize(ctx, test.size), defaultLobChunkSize); size != test.expected {
+			t.Fatalf("size %d: got %d - expected %d", test.size, size, test.expected)
+		}
+	}
+	if size := lobChunkSize(ctx, defaultLobChunkSize); size != defaultLobChunkSize {
+		t.Fatalf("got %d - expected %d", size, defaultLobChunkSize)
+	}
+
+	table := RandomIdentifier("lobChunkSize_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, b blob)", table)); err != nil {
+		t.Fatalf("create table failed: %s", err)
+	}
+
+	content := bytes.Repeat([]byte("go-hdb lob "), 1000)
+
+	// use trancactions:
+	// SQL Error 596 - LOB streaming is not permitted in auto-commit mode
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, size := range []int{minLobChunkSize, 1000, 0} {
+		if _, err := tx.ExecContext(WithLobChunkSize(ctx, size), fmt.Sprintf("insert into %s values (?, ?)", table), i, NewLob(bytes.NewReader(content), nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select b from %s order by i", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var b bytesLob
+		if err := rows.Scan(&b); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b, content) {
+			t.Fatalf("got %d bytes - expected %d", len(b), len(content))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestLob(t *testing.T) {
 	tests := []struct {
 		name string
@@ -255,6 +721,13 @@ func TestLob(t *testing.T) {
 		{"insert", testLobInsert},
 		{"pipe", testLobPipe},
 		{"delayedScan", testLobDelayedScan},
+		{"maxOpenLobs", testLobMaxOpenLobs},
+		{"empty", testLobEmpty},
+		{"encoding", testLobEncoding},
+		{"writeTo", testLobWriteTo},
+		{"streamCancel", testLobStreamCancel},
+		{"scanCancel", testLobScanCancel},
+		{"chunkSize", testLobChunkSize},
 	}
 
 	db := MT.DB()
@@ -0,0 +1,101 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// Meter instrument names.
+const (
+	MeterOperationDuration = "hdb.client.operation.duration" // histogram: duration of database operations in seconds
+	MeterRowsFetched       = "hdb.client.rows.fetched"       // counter: number of fetched result set rows
+	MeterBytesRead         = "hdb.client.bytes.read"         // counter: number of bytes read from database connections
+	MeterBytesWritten      = "hdb.client.bytes.written"      // counter: number of bytes written to database connections
+	MeterErrors            = "hdb.client.errors"             // counter: number of errors
+	MeterConnections       = "hdb.client.connections"        // up-down counter: number of open connections
+)
+
+// Meter attribute keys.
+const (
+	MeterAttrOperation  = "db.operation.name" // operation (see statistics sql times: query, prepare, exec, call, fetch, ...)
+	MeterAttrErrorClass = "error.type"        // error class (see MeterErrorClass constants)
+)
+
+// Meter error classes.
+const (
+	MeterErrorClassCanceled       = "canceled"       // context canceled or statement cancelled
+	MeterErrorClassTimeout        = "timeout"        // context deadline exceeded
+	MeterErrorClassConnection     = "connection"     // bad database connection
+	MeterErrorClassAuthentication = "authentication" // authentication failed
+	MeterErrorClassDatabase       = "database"       // error returned by the database server
+	MeterErrorClassDriver         = "driver"         // any other error
+)
+
+// MeterAttr is a meter attribute.
+type MeterAttr struct {
+	Key, Value string
+}
+
+/*
+Meter is the interface to record driver metrics in a metrics pipeline like OpenTelemetry (see SetMeter).
+The instruments are identified by the Meter instrument name constants, the attributes are limited to
+the Meter attribute keys with a fixed set of values, so that no high-cardinality attributes (like sql
+statements) are recorded.
+
+An OpenTelemetry adapter would create the instruments via a metric.Meter (Int64Counter, Int64UpDownCounter
+and Float64Histogram) and map the attributes to attribute.String values.
+
+Meter methods are called concurrently and on the database call path, so implementations need to be
+safe for concurrent use and should not block.
+*/
+type Meter interface {
+	// Add adds value to the counter or up-down counter instrument name.
+	Add(ctx context.Context, name string, value int64, attrs ...MeterAttr)
+	// Record records value in the histogram instrument name.
+	Record(ctx context.Context, name string, value float64, attrs ...MeterAttr)
+}
+
+// meterErrorClass returns the meter error class of err.
+func meterErrorClass(err error) string {
+	switch {
+	case errors.Is(err, errCancelled), errors.Is(err, context.Canceled):
+		return MeterErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return MeterErrorClassTimeout
+	case isAuthError(err):
+		return MeterErrorClassAuthentication
+	case errors.Is(err, driver.ErrBadConn):
+		return MeterErrorClassConnection
+	}
+	var hdbErrors *p.HdbErrors
+	if errors.As(err, &hdbErrors) {
+		return MeterErrorClassDatabase
+	}
+	return MeterErrorClassDriver
+}
+
+func meterError(ctx context.Context, meter Meter, err error) {
+	meter.Add(ctx, MeterErrors, 1, MeterAttr{Key: MeterAttrErrorClass, Value: meterErrorClass(err)})
+}
+
+// setLastError sets the last error of the connection and records it in the meter (if set).
+func (c *conn) setLastError(ctx context.Context, err error) {
+	c.lastError = err
+	if err != nil && c.attrs._meter != nil {
+		meterError(ctx, c.attrs._meter, err)
+	}
+}
+
+func (c *conn) meterSQLTime(d time.Duration, k int) {
+	c.attrs._meter.Record(context.Background(), MeterOperationDuration, d.Seconds(), MeterAttr{Key: MeterAttrOperation, Value: statsCfg.SQLTimeTexts[k]})
+}
+
+func (c *conn) meterRowsFetched(ctx context.Context, qr *queryResult) {
+	if c.attrs._meter != nil {
+		c.attrs._meter.Add(ctx, MeterRowsFetched, int64(qr.numRow()))
+	}
+}
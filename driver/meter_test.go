@@ -0,0 +1,109 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+type testMeter struct {
+	mu       sync.Mutex
+	counters map[string]int64
+	records  map[string]int
+	attrs    map[MeterAttr]int
+}
+
+func newTestMeter() *testMeter {
+	return &testMeter{counters: map[string]int64{}, records: map[string]int{}, attrs: map[MeterAttr]int{}}
+}
+
+func (m *testMeter) Add(ctx context.Context, name string, value int64, attrs ...MeterAttr) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name] += value
+	for _, attr := range attrs {
+		m.attrs[attr]++
+	}
+}
+
+func (m *testMeter) Record(ctx context.Context, name string, value float64, attrs ...MeterAttr) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[name]++
+	for _, attr := range attrs {
+		m.attrs[attr]++
+	}
+}
+
+func TestMeter(t *testing.T) {
+	t.Parallel()
+
+	const numRow = 10
+
+	meter := newTestMeter()
+	connector := MT.NewConnector()
+	connector.SetMeter(meter)
+	db := sql.OpenDB(connector)
+
+	table := RandomIdentifier("meter_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer)", table)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < numRow; i++ {
+		if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", table), i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rows, err := db.Query(fmt.Sprintf("select * from %s", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+	if n != numRow {
+		t.Fatalf("number of rows %d - expected %d", n, numRow)
+	}
+
+	// database error
+	if _, err := db.Exec(fmt.Sprintf("select * from %s", RandomIdentifier("unknown_"))); err == nil {
+		t.Fatal("database error expected")
+	}
+
+	db.Close() // close connections
+
+	meter.mu.Lock()
+	defer meter.mu.Unlock()
+
+	if meter.counters[MeterRowsFetched] != numRow {
+		t.Fatalf("rows fetched %d - expected %d", meter.counters[MeterRowsFetched], numRow)
+	}
+	for _, name := range []string{MeterBytesRead, MeterBytesWritten} {
+		if meter.counters[name] == 0 {
+			t.Fatalf("counter %s: no value recorded", name)
+		}
+	}
+	if meter.counters[MeterConnections] != 0 {
+		t.Fatalf("open connections %d - expected 0", meter.counters[MeterConnections])
+	}
+	if meter.records[MeterOperationDuration] == 0 {
+		t.Fatalf("histogram %s: no value recorded", MeterOperationDuration)
+	}
+	for _, op := range []string{"query", "prepare", "exec"} {
+		if meter.attrs[MeterAttr{Key: MeterAttrOperation, Value: op}] == 0 {
+			t.Fatalf("operation %s: no value recorded", op)
+		}
+	}
+	if meter.counters[MeterErrors] != 1 || meter.attrs[MeterAttr{Key: MeterAttrErrorClass, Value: MeterErrorClassDatabase}] != 1 {
+		t.Fatalf("errors %d attributes %v - expected 1 database error", meter.counters[MeterErrors], meter.attrs)
+	}
+}
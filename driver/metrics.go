@@ -100,10 +100,11 @@ type sqlTimeMsg struct {
 const numMetricCollectorCh = 100
 
 type metrics struct {
-	mu    sync.RWMutex
-	once  sync.Once // lazy init
-	wg    *sync.WaitGroup
-	msgCh chan any
+	mu        sync.RWMutex
+	once      sync.Once // lazy init
+	closeOnce sync.Once
+	wg        *sync.WaitGroup
+	msgCh     chan any
 
 	parentMetrics *metrics
 
@@ -160,9 +161,12 @@ func (m *metrics) lazyInit() {
 	})
 }
 
+// close stops the collect go routine after all pending messages are processed (flush).
 func (m *metrics) close() {
-	close(m.msgCh)
-	m.wg.Wait()
+	m.closeOnce.Do(func() {
+		close(m.msgCh)
+		m.wg.Wait()
+	})
 }
 
 func (m *metrics) stats() *Stats {
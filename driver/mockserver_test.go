@@ -0,0 +1,250 @@
+package driver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+const (
+	mockInitRequestSize   = 14
+	mockInitReplySize     = 8
+	mockMessageHeaderSize = 32
+	mockSegmentHeaderSize = 24
+	mockPartHeaderSize    = 16
+	mockSegmentKindReply  = 2
+	mockSessionID         = 4711
+)
+
+// mockRequest is a client request received by the mock server.
+type mockRequest struct {
+	messageType p.MessageType
+	parts       map[p.PartKind][]byte
+}
+
+// command returns the statement text of the request.
+func (r *mockRequest) command() string { return string(r.parts[p.PkCommand]) }
+
+// mockPart is a reply part sent by the mock server.
+type mockPart struct {
+	kind   p.PartKind
+	numArg int
+	data   []byte
+}
+
+// mockReply is a reply sent by the mock server.
+type mockReply struct {
+	functionCode p.FunctionCode
+	parts        []mockPart
+}
+
+// mockHandler answers statement requests. A nil reply closes the connection without answering the request.
+type mockHandler func(req *mockRequest) *mockReply
+
+/*
+mockServer is a minimal database server implementing the protocol subset needed to test the driver without
+a database: the protocol prolog, the authentication (any credentials are accepted) and requests answered
+by a handler (e.g. statements and database connect info requests).
+*/
+type mockServer struct {
+	l       net.Listener
+	handler mockHandler
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func newMockServer(t *testing.T, handler mockHandler) *mockServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &mockServer{l: l, handler: handler, conns: map[net.Conn]struct{}{}}
+	t.Cleanup(s.close)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns[conn] = struct{}{}
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+// host returns the host (address) of the mock server.
+func (s *mockServer) host() string { return s.l.Addr().String() }
+
+// close stops the mock server and closes all client connections (e.g. to simulate a database server failure).
+func (s *mockServer) close() {
+	s.l.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	clear(s.conns)
+}
+
+func (s *mockServer) serve(conn net.Conn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		conn.Close()
+	}()
+
+	if _, err := io.ReadFull(conn, make([]byte, mockInitRequestSize)); err != nil {
+		return
+	}
+	if _, err := conn.Write(make([]byte, mockInitReplySize)); err != nil {
+		return
+	}
+	for {
+		req, err := readMockRequest(conn)
+		if err != nil {
+			return
+		}
+		var reply *mockReply
+		switch req.messageType {
+		case p.MtAuthenticate:
+			reply = mockAuthReply(req, []byte("logonname"))
+		case p.MtConnect:
+			reply = mockAuthReply(req, []byte("cookie"))
+		case p.MtDisconnect:
+			return
+		default:
+			if reply = s.handler(req); reply == nil {
+				return
+			}
+		}
+		if _, err := conn.Write(reply.bytes()); err != nil {
+			return
+		}
+	}
+}
+
+func mockPadBytes(size int) int { return (8 - size%8) % 8 }
+
+func readMockRequest(rd io.Reader) (*mockRequest, error) {
+	mh := make([]byte, mockMessageHeaderSize)
+	if _, err := io.ReadFull(rd, mh); err != nil {
+		return nil, err
+	}
+	b := make([]byte, binary.LittleEndian.Uint32(mh[12:16])) // variable part length
+	if _, err := io.ReadFull(rd, b); err != nil {
+		return nil, err
+	}
+	if len(b) < mockSegmentHeaderSize {
+		return nil, errors.New("mock server: invalid segment")
+	}
+	req := &mockRequest{messageType: p.MessageType(b[13]), parts: map[p.PartKind][]byte{}}
+	numPart := int(binary.LittleEndian.Uint16(b[8:10]))
+	b = b[mockSegmentHeaderSize:]
+	for i := 0; i < numPart; i++ {
+		if len(b) < mockPartHeaderSize {
+			return nil, errors.New("mock server: invalid part header")
+		}
+		kind := p.PartKind(b[0])
+		size := int(binary.LittleEndian.Uint32(b[8:12])) // buffer length
+		b = b[mockPartHeaderSize:]
+		if len(b) < size {
+			return nil, errors.New("mock server: invalid part")
+		}
+		req.parts[kind] = b[:size]
+		b = b[min(size+mockPadBytes(size), len(b)):]
+	}
+	return req, nil
+}
+
+// mockAuthReply returns the authentication reply for the first authentication method offered by the client.
+func mockAuthReply(req *mockRequest, prm []byte) *mockReply {
+	var methodType []byte
+	if b := req.parts[p.PkAuthentication]; len(b) > 2 {
+		b = b[2:]                        // number of parameters
+		b = b[min(1+int(b[0]), len(b)):] // skip logonname
+		if len(b) > 0 {
+			methodType = b[1:min(1+int(b[0]), len(b))]
+		}
+	}
+	buf := new(bytes.Buffer)
+	mockWrite(buf, int16(2), uint8(len(methodType)), methodType, uint8(len(prm)), prm)
+	return &mockReply{parts: []mockPart{{kind: p.PkAuthentication, numArg: 1, data: buf.Bytes()}}}
+}
+
+func mockWrite(buf *bytes.Buffer, values ...any) {
+	for _, v := range values {
+		binary.Write(buf, binary.LittleEndian, v) //nolint:errcheck // bytes.Buffer does not return write errors
+	}
+}
+
+func (r *mockReply) bytes() []byte {
+	parts := new(bytes.Buffer)
+	for _, part := range r.parts {
+		size := int32(len(part.data))
+		mockWrite(parts, int8(part.kind), int8(0), int16(part.numArg), int32(0), size, size, part.data, make([]byte, mockPadBytes(len(part.data))))
+	}
+	size := uint32(mockSegmentHeaderSize + parts.Len())
+
+	buf := new(bytes.Buffer)
+	// message header
+	mockWrite(buf, int64(mockSessionID), int32(0), size, size, int16(1), make([]byte, 10))
+	// segment header
+	mockWrite(buf, int32(size), int32(0), int16(len(r.parts)), int16(1), int8(mockSegmentKindReply), int8(0), int16(r.functionCode), make([]byte, 8))
+	buf.Write(parts.Bytes())
+	return buf.Bytes()
+}
+
+// mockRowsAffectedPart returns a rows affected part.
+func mockRowsAffectedPart(rows ...int32) mockPart {
+	buf := new(bytes.Buffer)
+	mockWrite(buf, rows)
+	return mockPart{kind: p.PkRowsAffected, numArg: len(rows), data: buf.Bytes()}
+}
+
+// mockWarningPart returns an error part containing a single warning.
+func mockWarningPart(code int32, text string) mockPart {
+	const warningLevel = 0
+	buf := new(bytes.Buffer)
+	// a single error is followed by one byte (see HdbErrors decoding).
+	mockWrite(buf, code, int32(0), int32(len(text)), int8(warningLevel), []byte("HY000"), []byte(text), int8(0))
+	return mockPart{kind: p.PkError, numArg: 1, data: buf.Bytes()}
+}
+
+// mockDBConnectInfoPart returns a database connect info part redirecting to host.
+func mockDBConnectInfoPart(host string) mockPart {
+	const (
+		ciHost        = 2
+		ciPort        = 3
+		ciIsConnected = 4
+		tcInteger     = 0x03
+		tcBoolean     = 0x1C
+		tcString      = 0x1D
+	)
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		panic(err)
+	}
+	portNo, err := net.LookupPort("tcp", port)
+	if err != nil {
+		panic(err)
+	}
+	buf := new(bytes.Buffer)
+	mockWrite(buf,
+		int8(ciHost), int8(tcString), int16(len(hostname)), []byte(hostname),
+		int8(ciPort), int8(tcInteger), int32(portNo),
+		int8(ciIsConnected), int8(tcBoolean), false,
+	)
+	return mockPart{kind: p.PkDBConnectInfo, numArg: 3, data: buf.Bytes()}
+}
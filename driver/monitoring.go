@@ -0,0 +1,99 @@
+package driver
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // md5 is used by the database server for statement hashes
+	"database/sql"
+	"encoding/hex"
+	"time"
+)
+
+// BackupCatalogEntry represents an entry of the backup catalog (monitoring view SYS.M_BACKUP_CATALOG).
+type BackupCatalogEntry struct {
+	EntryID       int64          `sql:"ENTRY_ID"`
+	EntryTypeName string         `sql:"ENTRY_TYPE_NAME"` // e.g. complete data backup, log backup
+	BackupID      int64          `sql:"BACKUP_ID"`
+	SysStartTime  time.Time      `sql:"SYS_START_TIME"`
+	SysEndTime    sql.NullTime   `sql:"SYS_END_TIME"`
+	StateName     string         `sql:"STATE_NAME"` // e.g. running, successful, failed, canceled
+	Comment       sql.NullString `sql:"COMMENT"`
+	Message       sql.NullString `sql:"MESSAGE"`
+}
+
+// SystemReplication represents the system replication status of a secondary site (monitoring view SYS.M_SYSTEM_REPLICATION).
+type SystemReplication struct {
+	SiteID                   int64          `sql:"SITE_ID"`
+	SiteName                 string         `sql:"SITE_NAME"`
+	SecondarySiteID          int64          `sql:"SECONDARY_SITE_ID"`
+	SecondarySiteName        string         `sql:"SECONDARY_SITE_NAME"`
+	ReplicationMode          string         `sql:"REPLICATION_MODE"` // e.g. SYNC, SYNCMEM, ASYNC
+	OperationMode            string         `sql:"OPERATION_MODE"`
+	ReplicationStatus        string         `sql:"REPLICATION_STATUS"` // e.g. ACTIVE, ERROR, SYNCING, INITIALIZING
+	ReplicationStatusDetails sql.NullString `sql:"REPLICATION_STATUS_DETAILS"`
+	Tier                     int            `sql:"TIER"`
+}
+
+// PlanCacheEntry represents an entry of the sql plan cache (monitoring view SYS.M_SQL_PLAN_CACHE).
+// Execution times are provided in microseconds.
+type PlanCacheEntry struct {
+	StatementHash          string       `sql:"STATEMENT_HASH"`
+	PlanID                 int64        `sql:"PLAN_ID"`
+	UserName               string       `sql:"USER_NAME"`
+	SchemaName             string       `sql:"SCHEMA_NAME"`
+	ExecutionCount         int64        `sql:"EXECUTION_COUNT"`
+	TotalExecutionTime     int64        `sql:"TOTAL_EXECUTION_TIME"`
+	AvgExecutionTime       int64        `sql:"AVG_EXECUTION_TIME"`
+	MaxExecutionTime       int64        `sql:"MAX_EXECUTION_TIME"`
+	LastExecutionTimestamp sql.NullTime `sql:"LAST_EXECUTION_TIMESTAMP"`
+}
+
+const (
+	backupCatalogQuery      = "select entry_id, entry_type_name, backup_id, sys_start_time, sys_end_time, state_name, comment, message from sys.m_backup_catalog order by sys_start_time desc, entry_id desc"
+	backupCatalogLimitQuery = backupCatalogQuery + " limit ?"
+	planCacheQuery          = "select statement_hash, plan_id, user_name, schema_name, execution_count, total_execution_time, avg_execution_time, max_execution_time, last_execution_timestamp from sys.m_sql_plan_cache where statement_hash = ? order by plan_id"
+	systemReplicationQuery  = "select site_id, site_name, secondary_site_id, secondary_site_name, replication_mode, operation_mode, replication_status, replication_status_details, tier from sys.m_system_replication order by tier, secondary_site_id"
+)
+
+/*
+Monitor provides typed access to common database monitoring views.
+
+Querying monitoring views requires the respective privileges (e.g. system privilege CATALOG READ or
+BACKUP ADMIN for the backup catalog and MONITORING for the system replication status and the sql plan cache).
+*/
+type Monitor struct {
+	q Queryer
+}
+
+// NewMonitor returns a new Monitor instance querying the monitoring views via q.
+func NewMonitor(q Queryer) *Monitor { return &Monitor{q: q} }
+
+// BackupCatalog returns the latest n entries of the backup catalog ordered by start time descending.
+// All entries are returned if n is less or equal zero.
+func (m *Monitor) BackupCatalog(ctx context.Context, n int) ([]BackupCatalogEntry, error) {
+	if n <= 0 {
+		return queryCatalog[BackupCatalogEntry](ctx, m.q, backupCatalogQuery)
+	}
+	return queryCatalog[BackupCatalogEntry](ctx, m.q, backupCatalogLimitQuery, n)
+}
+
+// SystemReplication returns the system replication status of all secondary sites.
+// An empty result is returned if system replication is not configured.
+func (m *Monitor) SystemReplication(ctx context.Context) ([]SystemReplication, error) {
+	return queryCatalog[SystemReplication](ctx, m.q, systemReplicationQuery)
+}
+
+// StatementHash returns the hash of statement the database server uses to identify statements
+// in monitoring views (MD5 hash of the statement string).
+func StatementHash(statement string) string {
+	hash := md5.Sum([]byte(statement)) //nolint:gosec
+	return hex.EncodeToString(hash[:])
+}
+
+// PlanCache returns the sql plan cache entries of statement (one entry per user and schema the statement
+// was executed with). An empty result is returned if the statement is not contained in the plan cache.
+//
+// Please note that the statement needs to match the statement string sent to the database server exactly,
+// including hints (see WithStatementMemoryLimit) and tags (see Connector.SetStatementTagger).
+func (m *Monitor) PlanCache(ctx context.Context, statement string) ([]PlanCacheEntry, error) {
+	return queryCatalog[PlanCacheEntry](ctx, m.q, planCacheQuery, StatementHash(statement))
+}
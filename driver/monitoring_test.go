@@ -0,0 +1,89 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestMonitor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	monitor := NewMonitor(MT.DB())
+
+	t.Run("backupCatalog", func(t *testing.T) {
+		entries, err := monitor.BackupCatalog(ctx, 0)
+		if err != nil {
+			if IsInsufficientPrivilege(err) {
+				t.Skip(err)
+			}
+			t.Fatal(err)
+		}
+		for i := 1; i < len(entries); i++ {
+			if entries[i].SysStartTime.After(entries[i-1].SysStartTime) {
+				t.Fatalf("entry %d start time %v after %v - expected descending order", i, entries[i].SysStartTime, entries[i-1].SysStartTime)
+			}
+		}
+
+		const n = 1
+		latest, err := monitor.BackupCatalog(ctx, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(latest) != min(n, len(entries)) {
+			t.Fatalf("number of entries %d - expected %d", len(latest), min(n, len(entries)))
+		}
+	})
+
+	t.Run("planCache", func(t *testing.T) {
+		statement := fmt.Sprintf("select '%s' from dummy", RandomIdentifier("planCache_"))
+
+		const numExec = 3
+		db := MT.DB()
+		for i := 0; i < numExec; i++ {
+			var s string
+			if err := db.QueryRowContext(ctx, statement).Scan(&s); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		entries, err := monitor.PlanCache(ctx, statement)
+		if err != nil {
+			if IsInsufficientPrivilege(err) {
+				t.Skip(err)
+			}
+			t.Fatal(err)
+		}
+		if len(entries) == 0 {
+			t.Fatalf("no plan cache entry for statement %s", statement)
+		}
+		var executionCount int64
+		for _, entry := range entries {
+			if entry.StatementHash != StatementHash(statement) {
+				t.Fatalf("statement hash %s - expected %s", entry.StatementHash, StatementHash(statement))
+			}
+			executionCount += entry.ExecutionCount
+		}
+		if executionCount < numExec {
+			t.Fatalf("execution count %d - expected at least %d", executionCount, numExec)
+		}
+	})
+
+	t.Run("systemReplication", func(t *testing.T) {
+		sites, err := monitor.SystemReplication(ctx)
+		if err != nil {
+			if IsInsufficientPrivilege(err) {
+				t.Skip(err)
+			}
+			t.Fatal(err)
+		}
+		for _, site := range sites {
+			if site.SiteName == "" || site.ReplicationStatus == "" {
+				t.Fatalf("invalid system replication status %v", site)
+			}
+		}
+	})
+}
@@ -0,0 +1,67 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func testNullNumericAsZero(t *testing.T, tableName Identifier, nullNumericAsZero bool) {
+	connector := MT.NewConnector()
+	connector.SetNullNumericAsZero(nullNumericAsZero)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	var (
+		i  int
+		f  float64
+		d  Decimal
+		ts time.Time
+		s  sql.NullString
+	)
+	err := db.QueryRow(fmt.Sprintf("select i, f, d, ts, s from %s", tableName)).Scan(&i, &f, &d, &ts, &s)
+
+	if !nullNumericAsZero {
+		if err == nil {
+			t.Fatal("scan error expected")
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != 0 || f != 0 || (*big.Rat)(&d).Sign() != 0 || !ts.IsZero() {
+		t.Fatalf("values %d %f %s %v - expected zero values", i, f, (*big.Rat)(&d).String(), ts)
+	}
+	if s.Valid { // non numeric types are not affected
+		t.Fatalf("string %v - expected NULL", s)
+	}
+}
+
+func TestNullNumericAsZero(t *testing.T) {
+	t.Parallel()
+
+	tableName := RandomIdentifier("nullNumeric_")
+
+	db := MT.DB()
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, f double, d decimal(10,2), ts timestamp, s nvarchar(10))", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (null, null, null, null, null)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, nullNumericAsZero := range []bool{false, true} {
+		nullNumericAsZero := nullNumericAsZero // new nullNumericAsZero to run in parallel
+
+		t.Run(fmt.Sprintf("nullNumericAsZero %t", nullNumericAsZero), func(t *testing.T) {
+			t.Parallel()
+			testNullNumericAsZero(t, tableName, nullNumericAsZero)
+		})
+	}
+}
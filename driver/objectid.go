@@ -0,0 +1,53 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+/*
+An ObjectID is the driver representation of a database metadata object id
+(e.g. column TABLE_OID of system view SYS.TABLES or OBJECT_OID of system view SYS.OBJECTS).
+
+Depending on the system view and the sql expression, object ids are provided as integer (BIGINT),
+decimal or character values. ObjectID scans all of these representations into an int64 value.
+*/
+type ObjectID int64
+
+// Scan implements the database/sql/Scanner interface.
+func (id *ObjectID) Scan(src any) error {
+	switch src := src.(type) {
+	case int64:
+		*id = ObjectID(src)
+		return nil
+	case *big.Rat:
+		if !src.IsInt() || !src.Num().IsInt64() {
+			return fmt.Errorf("object id: invalid decimal value %s", src.RatString())
+		}
+		*id = ObjectID(src.Num().Int64())
+		return nil
+	case []byte:
+		return id.parse(string(src))
+	case string:
+		return id.parse(src)
+	default:
+		return fmt.Errorf("object id: invalid data type %T", src)
+	}
+}
+
+func (id *ObjectID) parse(s string) error {
+	i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return fmt.Errorf("object id: %w", err)
+	}
+	*id = ObjectID(i)
+	return nil
+}
+
+// Value implements the database/sql/Valuer interface.
+func (id ObjectID) Value() (driver.Value, error) { return int64(id), nil }
+
+func (id ObjectID) String() string { return strconv.FormatInt(int64(id), 10) }
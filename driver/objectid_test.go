@@ -0,0 +1,30 @@
+package driver
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestObjectID(t *testing.T) {
+	const oid = 172634
+
+	for _, src := range []any{int64(oid), big.NewRat(oid, 1), []byte("172634"), "172634", "172634 "} {
+		var id ObjectID
+		if err := id.Scan(src); err != nil {
+			t.Fatalf("%v: %s", src, err)
+		}
+		if id != oid {
+			t.Fatalf("%v: got %d - expected %d", src, id, oid)
+		}
+		if id.String() != "172634" {
+			t.Fatalf("%v: got %s - expected %s", src, id, "172634")
+		}
+	}
+
+	for _, src := range []any{nil, big.NewRat(3, 2), "abc", []byte{0x01, 0x02}, float64(oid)} {
+		var id ObjectID
+		if err := id.Scan(src); err == nil {
+			t.Fatalf("%v: error expected", src)
+		}
+	}
+}
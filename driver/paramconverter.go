@@ -0,0 +1,106 @@
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+/*
+ParameterConverter converts the input parameter values of a statement ahead of execution, so that the values
+do not need to be converted again during statement execution.
+
+The parameter types are taken from the statement metadata provided by the database server (see DescribeStmt).
+Converted values of a type differing from the statement parameter type are converted again.
+
+A ParameterConverter is safe for concurrent use and can be shared, e.g. by generated data access code
+executing bulk inserts:
+
+	query := "insert into t values (?, ?)"
+	m, err := driver.DescribeStmt(ctx, conn, query)
+	...
+	conv, err := driver.NewParameterConverter(m)
+	...
+	db.Exec(query, func(args []any) error {
+		...
+		args[0], args[1] = id, name
+		return conv.ConvertRow(args)
+	})
+*/
+type ParameterConverter struct {
+	convs []*p.Converter
+}
+
+// convertedColumn is the column type returned by ParameterConverter.ConvertColumn.
+type convertedColumn []any
+
+// NewParameterConverter returns a new ParameterConverter for the input parameters described by the statement metadata m.
+func NewParameterConverter(m *StmtMetadata) (*ParameterConverter, error) {
+	convs := make([]*p.Converter, 0, m.NumInput())
+	for i, prm := range m.Parameters {
+		if !prm.In {
+			continue
+		}
+		conv, err := p.NewConverter(prm.DatabaseTypeName)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+		convs = append(convs, conv)
+	}
+	return &ParameterConverter{convs: convs}, nil
+}
+
+func (c *ParameterConverter) convert(idx int, v any) (any, error) {
+	v, err := valuerArg(v)
+	if err != nil {
+		return nil, err
+	}
+	return c.convs[idx].Convert(v)
+}
+
+// ConvertRow converts the values of row in place. The number of row values needs to match the number of input parameters.
+func (c *ParameterConverter) ConvertRow(row []any) error {
+	if len(row) != len(c.convs) {
+		return fmt.Errorf("invalid number of row values %d - expected %d", len(row), len(c.convs))
+	}
+	for i, v := range row {
+		namedArg, isNamed := v.(sql.NamedArg)
+		if isNamed {
+			v = namedArg.Value
+		}
+		v, err := c.convert(i, v)
+		if err != nil {
+			return fmt.Errorf("column %d: %w", i, err)
+		}
+		if isNamed {
+			namedArg.Value = v
+			v = namedArg
+		}
+		row[i] = v
+	}
+	return nil
+}
+
+/*
+ConvertColumn converts the values of column (slice) of the input parameter with index idx. The converted column
+can be provided to a BulkInserter, which uses the converted values without converting them again.
+*/
+func (c *ParameterConverter) ConvertColumn(idx int, column any) (any, error) {
+	if idx < 0 || idx >= len(c.convs) {
+		return nil, fmt.Errorf("invalid column index %d - number of columns %d", idx, len(c.convs))
+	}
+	rv := reflect.ValueOf(column)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("invalid column type %T - slice expected", column)
+	}
+	values := make(convertedColumn, rv.Len())
+	for i := range values {
+		var err error
+		if values[i], err = c.convert(idx, rv.Index(i).Interface()); err != nil {
+			return nil, fmt.Errorf("column %d row %d: %w", idx, i, err)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,54 @@
+package driver
+
+import (
+	"testing"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+func TestParameterConverter(t *testing.T) {
+	m := &StmtMetadata{Parameters: []ParameterDescr{
+		{DatabaseTypeName: "INTEGER", In: true},
+		{DatabaseTypeName: "NVARCHAR", In: true, Out: true},
+		{DatabaseTypeName: "DECIMAL", Out: true}, // output parameters are not converted
+	}}
+	conv, err := NewParameterConverter(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := []any{42, "go-hdb"}
+	if err := conv.ConvertRow(row); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range row {
+		if _, ok := v.(p.ConvertedValue); !ok {
+			t.Fatalf("column %d: value type %T - expected %T", i, v, p.ConvertedValue{})
+		}
+	}
+	if err := conv.ConvertRow([]any{42, "go-hdb", 1}); err == nil {
+		t.Fatal("invalid number of row values error expected")
+	}
+
+	column, err := conv.ConvertColumn(0, []int{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, ok := column.(convertedColumn)
+	if !ok || len(values) != 3 {
+		t.Fatalf("column %v - expected converted column of length %d", column, 3)
+	}
+	if _, err := conv.ConvertColumn(2, []int{1}); err == nil {
+		t.Fatal("invalid column index error expected")
+	}
+	if _, err := conv.ConvertColumn(0, 42); err == nil {
+		t.Fatal("invalid column type error expected")
+	}
+	if _, err := conv.ConvertColumn(0, []string{"x"}); err == nil {
+		t.Fatal("conversion error expected")
+	}
+
+	if _, err := NewParameterConverter(&StmtMetadata{Parameters: []ParameterDescr{{DatabaseTypeName: "UNKNOWN(98)", In: true}}}); err == nil {
+		t.Fatal("invalid database type name error expected")
+	}
+}
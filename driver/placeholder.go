@@ -0,0 +1,166 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/SAP/go-hdb/driver/internal/protocol/levenshtein"
+)
+
+// MaxNumParameter is the maximum number of parameters (placeholders) supported by the database server for a single statement.
+// Bulk operations like multi-row inserts are not affected as the parameter values are sent in batches of rows (see Connector.SetBulkSize).
+const MaxNumParameter = math.MaxInt16
+
+// isPlaceholderNameChar returns true if b is a valid character of a placeholder name.
+func isPlaceholderNameChar(b byte) bool {
+	return b == '_' || b == '#' || b == '$' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// parsePlaceholders calls fn for each parameter placeholder of a sql statement skipping string literals,
+// quoted identifiers and comments. The name is empty for positional placeholders ('?') and the name
+// (number) without the leading colon for named (numbered) placeholders.
+func parsePlaceholders(query string, fn func(name string)) {
+	for i := 0; i < len(query); i++ {
+		switch query[i] {
+		case '?':
+			fn("")
+		case ':':
+			j := i + 1
+			for j < len(query) && isPlaceholderNameChar(query[j]) {
+				j++
+			}
+			if j > i+1 {
+				fn(query[i+1 : j])
+				i = j - 1
+			}
+		case '\'', '"': // string literal or quoted identifier (escaped quotes are handled as two consecutive literals)
+			if j := strings.IndexByte(query[i+1:], query[i]); j == -1 {
+				i = len(query)
+			} else {
+				i += j + 1
+			}
+		case '-':
+			if i+1 < len(query) && query[i+1] == '-' { // line comment
+				if j := strings.IndexByte(query[i+2:], '\n'); j == -1 {
+					i = len(query)
+				} else {
+					i += j + 2
+				}
+			}
+		case '/':
+			if i+1 < len(query) && query[i+1] == '*' { // block comment
+				if j := strings.Index(query[i+2:], "*/"); j == -1 {
+					i = len(query)
+				} else {
+					i += j + 3
+				}
+			}
+		}
+	}
+}
+
+// numPlaceholder returns the number of parameter placeholders ('?') of a sql statement
+// skipping string literals, quoted identifiers and comments.
+func numPlaceholder(query string) int {
+	n := 0
+	parsePlaceholders(query, func(name string) {
+		if name == "" {
+			n++
+		}
+	})
+	return n
+}
+
+/*
+namedPlaceholders returns the distinct names of the named placeholders of a sql statement in order
+of their first appearance.
+
+Besides the positional placeholder '?', the database server supports numbered (':1', ':2', ...) and
+named (':name') placeholders. Positional arguments are bound as follows:
+
+  - a numbered placeholder ':n' refers to the n-th argument,
+  - named placeholders are bound in order of their first appearance in the statement, so that
+    a repeated name refers to the same parameter and argument.
+
+Example:
+
+	// binds 1 to :id and "x" to :name - the second occurrence of :id refers to the first argument again
+	db.Query("select * from t where id = :id and name = :name or parent_id = :id", 1, "x")
+
+Alternatively named arguments (sql.Named) are bound to the named placeholders by name (see bindNamedArgs):
+
+	db.Query("select * from t where id = :id and name = :name or parent_id = :id", sql.Named("name", "x"), sql.Named("id", 1))
+*/
+func namedPlaceholders(query string) []string {
+	var names []string
+	parsePlaceholders(query, func(name string) {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	})
+	return names
+}
+
+/*
+bindNamedArgs reorders nvargs in place in order of the named placeholders of query in case named arguments (sql.Named) are used.
+Named and positional arguments cannot be mixed and named arguments are only supported for statements using named
+placeholders exclusively. For bulk statements the arguments of each row need to be named.
+*/
+func bindNamedArgs(query string, nvargs []driver.NamedValue) error {
+	idx := slices.IndexFunc(nvargs, func(nvarg driver.NamedValue) bool { return nvarg.Name != "" })
+	if idx == -1 {
+		return nil
+	}
+	names := namedPlaceholders(query)
+	if len(names) == 0 {
+		return fmt.Errorf("invalid argument %s - statement does not contain named placeholders", nvargs[idx].Name)
+	}
+	if numPlaceholder(query) != 0 {
+		return fmt.Errorf("invalid argument %s - named arguments are not supported for statements with positional placeholders", nvargs[idx].Name)
+	}
+	if len(nvargs)%len(names) != 0 {
+		return numArgError(query, len(nvargs), len(names))
+	}
+	args := make([]driver.NamedValue, len(nvargs))
+	for ofs := 0; ofs < len(nvargs); ofs += len(names) {
+		for _, nvarg := range nvargs[ofs : ofs+len(names)] {
+			if nvarg.Name == "" {
+				return fmt.Errorf("invalid argument %d - named and positional arguments cannot be mixed", nvarg.Ordinal)
+			}
+			i := slices.Index(names, nvarg.Name)
+			if i == -1 {
+				return fmt.Errorf("invalid argument name %s - did you mean %s?", nvarg.Name, levenshtein.MinString(names, func(name string) string { return name }, nvarg.Name, false))
+			}
+			if args[ofs+i].Ordinal != 0 {
+				return fmt.Errorf("duplicate argument name %s", nvarg.Name)
+			}
+			args[ofs+i] = driver.NamedValue{Ordinal: ofs + i + 1, Value: nvarg.Value}
+		}
+	}
+	copy(nvargs, args)
+	return nil
+}
+
+// numArgError returns the invalid number of arguments error of query adding the binding order
+// of named placeholders if contained in query.
+func numArgError(query string, numArg, numParameter int) error {
+	names := namedPlaceholders(query)
+	if len(names) == 0 {
+		return fmt.Errorf("invalid number of arguments %d - expected %d", numArg, numParameter)
+	}
+	return fmt.Errorf("invalid number of arguments %d - expected %d (arguments are bound in order of first appearance :%s)", numArg, numParameter, strings.Join(names, ", :"))
+}
+
+// checkNumPlaceholder returns an error if the number of parameter placeholders of query exceeds MaxNumParameter.
+func checkNumPlaceholder(query string) error {
+	if strings.Count(query, "?") <= MaxNumParameter { // fast path
+		return nil
+	}
+	if n := numPlaceholder(query); n > MaxNumParameter {
+		return fmt.Errorf("number of statement parameters %d exceeds maximum %d", n, MaxNumParameter)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNumPlaceholder(t *testing.T) {
+	testData := []struct {
+		query string
+		n     int
+	}{
+		{"select * from dummy", 0},
+		{"select * from t where a = ?", 1},
+		{"insert into t values (?, ?, ?)", 3},
+		{"select '?' from t where a = ?", 1},
+		{"select 'it''s ?' from t where a = ?", 1},
+		{`select "?col" from t where a = ? and b = ?`, 2},
+		{"select * from t -- where a = ?\nwhere b = ?", 1},
+		{"select * from t /* where a = ? */ where b = ?", 1},
+		{"select * from t where a = ? /* unterminated ?", 1},
+		{"select 8 - 2 from t where a = ?", 1},
+		{"select * from t where a = :a and b = ?", 1},
+	}
+
+	for _, r := range testData {
+		if n := numPlaceholder(r.query); n != r.n {
+			t.Fatalf("query %s: number of placeholders %d - expected %d", r.query, n, r.n)
+		}
+	}
+}
+
+func TestCheckNumPlaceholder(t *testing.T) {
+	query := func(n int) string {
+		return "select * from t where a in (" + strings.Repeat("?,", n-1) + "?)"
+	}
+	if err := checkNumPlaceholder(query(MaxNumParameter)); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkNumPlaceholder(query(MaxNumParameter + 1)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNamedPlaceholders(t *testing.T) {
+	testData := []struct {
+		query string
+		names []string
+	}{
+		{"select * from t where a = ?", nil},
+		{"select * from t where a = :1 and b = :1", []string{"1"}},
+		{"select * from t where a = :a and b = :b", []string{"a", "b"}},
+		{"select * from t where b = :b and a = :a or c = :b", []string{"b", "a"}},
+		{"select * from t where a = :a_1 and b = :B#$", []string{"a_1", "B#$"}},
+		{"select ':x' from t where a = :a", []string{"a"}},
+		{`select ":x" from t where a = :a -- :y`, []string{"a"}},
+		{"select * from t /* :x */ where a = :a", []string{"a"}},
+		{"select * from t where a = :", nil},
+	}
+
+	for _, r := range testData {
+		if names := namedPlaceholders(r.query); !slices.Equal(names, r.names) {
+			t.Fatalf("query %s: names %v - expected %v", r.query, names, r.names)
+		}
+	}
+
+	const query = "select * from t where a = :a and b = :b or c = :a"
+	if err := numArgError(query, 3, 2); !strings.Contains(err.Error(), ":a, :b") {
+		t.Fatalf("error %s does not contain binding order", err)
+	}
+}
+
+func TestTagQuery(t *testing.T) {
+	testData := []struct {
+		tag, query, expected string
+	}{
+		{"", "select * from dummy where a = ?", "select * from dummy where a = ?"},
+		{"req-1", "select * from dummy where a = ?", "/* req-1 */ select * from dummy where a = ?"},
+		{"a*/b", "select * from dummy", "/* a* /b */ select * from dummy"},
+	}
+
+	for _, d := range testData {
+		query := tagQuery(d.tag, d.query)
+		if query != d.expected {
+			t.Fatalf("tag %q: got %q - expected %q", d.tag, query, d.expected)
+		}
+		// tag must not change the number of placeholders
+		if n, m := numPlaceholder(query), numPlaceholder(d.query); n != m {
+			t.Fatalf("tag %q: number of placeholders %d - expected %d", d.tag, n, m)
+		}
+	}
+}
+
+func TestBindNamedArgs(t *testing.T) {
+	named := func(args ...any) []driver.NamedValue {
+		nvargs := make([]driver.NamedValue, 0, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			nvargs = append(nvargs, driver.NamedValue{Name: args[i].(string), Ordinal: i/2 + 1, Value: args[i+1]})
+		}
+		return nvargs
+	}
+
+	const query = "select * from t where id = :id and name = :name or parent_id = :id"
+
+	// reorder named arguments (two rows)
+	nvargs := named("name", "x", "id", 1, "id", 2, "name", "y")
+	if err := bindNamedArgs(query, nvargs); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range []any{1, "x", 2, "y"} {
+		if nvargs[i].Name != "" || nvargs[i].Ordinal != i+1 || nvargs[i].Value != v {
+			t.Fatalf("argument %d: got %v - expected %v", i, nvargs[i], v)
+		}
+	}
+
+	// positional arguments are not changed
+	nvargs = []driver.NamedValue{{Ordinal: 1, Value: "x"}, {Ordinal: 2, Value: 1}}
+	if err := bindNamedArgs(query, nvargs); err != nil || nvargs[0].Value != "x" {
+		t.Fatalf("got %v %v - expected unchanged arguments", nvargs, err)
+	}
+
+	testData := []struct {
+		query  string
+		nvargs []driver.NamedValue
+		errMsg string
+	}{
+		{query, named("nmae", "x", "id", 1), "did you mean name?"},
+		{query, named("id", "x", "id", 1), "duplicate argument name id"},
+		{query, named("id", 1, "name", "x", "id", 2), "invalid number of arguments"},
+		{query, []driver.NamedValue{{Name: "id", Ordinal: 1, Value: 1}, {Ordinal: 2, Value: "x"}}, "cannot be mixed"},
+		{"select * from t where id = ?", named("id", 1), "does not contain named placeholders"},
+		{"select * from t where id = :id and name = ?", named("id", 1, "name", "x"), "positional placeholders"},
+	}
+	for _, d := range testData {
+		if err := bindNamedArgs(d.query, d.nvargs); err == nil || !strings.Contains(err.Error(), d.errMsg) {
+			t.Fatalf("%s %v: got error %v - expected %s", d.query, d.nvargs, err, d.errMsg)
+		}
+	}
+}
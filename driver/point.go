@@ -0,0 +1,127 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/SAP/go-hdb/driver/spatial"
+)
+
+const (
+	wkbPointType      uint32 = 1
+	wkbPointSize             = 21 // byte order, type, x, y
+	ewkbPointSize            = 25 // byte order, type, srid, x, y
+	ewkbDimensionMask uint32 = 0xC0000000
+)
+
+/*
+A Point is the driver representation of a two-dimensional database spatial point (ST_POINT) with
+spatial reference system identifier SRID.
+
+Point binds as parameter in 'extended well known binary' (EWKB) format including the SRID, so that the SRID
+needs to match the SRID of the database field (e.g. ST_POINT(4326)). Scanning accepts 'well known binary' (WKB)
+and EWKB encoded points in both byte orders - the SRID of a WKB encoded point is 0. As the database server returns
+spatial field values WKB encoded, please select the value EWKB encoded to scan the SRID as well, e.g.
+
+	var p driver.Point
+	db.QueryRow("select p.st_asewkb() from t").Scan(&p)
+
+Please use NullPoint to scan nullable fields and Geometry for other geometry types or three- and four-dimensional
+points.
+*/
+type Point struct {
+	X, Y float64
+	SRID int32
+}
+
+// Scan implements the database/sql/Scanner interface.
+func (p *Point) Scan(src any) error {
+	if src == nil {
+		return fmt.Errorf("point: cannot scan NULL value - please use NullPoint")
+	}
+	var g Geometry
+	switch src.(type) {
+	case string, []byte:
+		if err := g.Scan(src); err != nil {
+			return fmt.Errorf("point: %w", err)
+		}
+	default: // binary lob (e.g. st_asewkb())
+		if err := ScanLobBytes(src, (*[]byte)(&g)); err != nil {
+			return fmt.Errorf("point: %w", err)
+		}
+	}
+	return p.decodeWKB(g)
+}
+
+// decodeWKB decodes the WKB or EWKB encoded point b.
+func (p *Point) decodeWKB(b []byte) error {
+	if len(b) != wkbPointSize && len(b) != ewkbPointSize {
+		return fmt.Errorf("point: invalid size %d - expected %d or %d", len(b), wkbPointSize, ewkbPointSize)
+	}
+	var order binary.ByteOrder
+	switch b[0] {
+	case spatial.NDR:
+		order = binary.LittleEndian
+	case spatial.XDR:
+		order = binary.BigEndian
+	default:
+		return fmt.Errorf("point: invalid byte order %d", b[0])
+	}
+	typ := order.Uint32(b[1:5])
+	if typ&^(ewkbSRIDFlag|ewkbDimensionMask) != wkbPointType || typ&ewkbDimensionMask != 0 {
+		return fmt.Errorf("point: invalid geometry type %d - two-dimensional point expected", typ)
+	}
+	hasSRID := typ&ewkbSRIDFlag != 0
+	if hasSRID != (len(b) == ewkbPointSize) {
+		return fmt.Errorf("point: invalid size %d for geometry type %d", len(b), typ)
+	}
+	ofs := 5
+	p.SRID = 0
+	if hasSRID {
+		p.SRID = int32(order.Uint32(b[5:9]))
+		ofs = 9
+	}
+	p.X = math.Float64frombits(order.Uint64(b[ofs : ofs+8]))
+	p.Y = math.Float64frombits(order.Uint64(b[ofs+8 : ofs+16]))
+	return nil
+}
+
+// Value implements the database/sql/Valuer interface.
+func (p Point) Value() (driver.Value, error) {
+	ewkb, err := spatial.EncodeEWKB(spatial.Point{X: p.X, Y: p.Y}, false, p.SRID) // hex representation
+	if err != nil {
+		return nil, err
+	}
+	return string(ewkb), nil
+}
+
+// NullPoint represents a Point that may be null.
+// NullPoint implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullPoint struct {
+	Point Point
+	Valid bool // Valid is true if Point is not NULL
+}
+
+// Scan implements the database/sql/Scanner interface.
+func (n *NullPoint) Scan(value any) error {
+	if value == nil {
+		n.Point, n.Valid = Point{}, false
+		return nil
+	}
+	if err := n.Point.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the database/sql/Valuer interface.
+func (n NullPoint) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Point.Value()
+}
@@ -0,0 +1,96 @@
+package driver
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/SAP/go-hdb/driver/spatial"
+)
+
+func TestPoint(t *testing.T) {
+	p := Point{X: 8.6821, Y: 50.1109, SRID: 4326}
+
+	// roundtrip
+	v, err := p.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sp Point
+	if err := sp.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if sp != p {
+		t.Fatalf("got %v - expected %v", sp, p)
+	}
+
+	// byte orders, binary and hex representation
+	for _, isXDR := range []bool{false, true} {
+		ewkb, err := spatial.EncodeEWKB(spatial.Point{X: p.X, Y: p.Y}, isXDR, p.SRID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := hex.DecodeString(string(ewkb))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, src := range []any{string(ewkb), b} {
+			var sp Point
+			if err := sp.Scan(src); err != nil {
+				t.Fatal(err)
+			}
+			if sp != p {
+				t.Fatalf("xdr %t %T: got %v - expected %v", isXDR, src, sp, p)
+			}
+		}
+	}
+
+	// wkb without srid
+	wkb, err := spatial.EncodeWKB(spatial.Point{X: p.X, Y: p.Y}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sp = Point{SRID: 4326}
+	if err := sp.Scan(string(wkb)); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Point{X: p.X, Y: p.Y}); sp != expected {
+		t.Fatalf("got %v - expected %v", sp, expected)
+	}
+
+	// invalid values
+	pointZ, err := spatial.EncodeWKB(spatial.PointZ{X: 1, Y: 2, Z: 3}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lineString, err := spatial.EncodeWKB(spatial.LineString{{X: 1, Y: 2}, {X: 3, Y: 4}}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, src := range []any{nil, "xyz", int64(42), string(pointZ), string(lineString)} {
+		if err := sp.Scan(src); err == nil {
+			t.Fatalf("%v: error expected", src)
+		}
+	}
+}
+
+func TestNullPoint(t *testing.T) {
+	var n NullPoint
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := n.Value(); n.Valid || v != nil || err != nil {
+		t.Fatalf("got %v %v %v - expected invalid NullPoint", n, v, err)
+	}
+
+	p := Point{X: 1, Y: 2}
+	v, err := p.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Point != p {
+		t.Fatalf("got %v - expected valid point %v", n, p)
+	}
+}
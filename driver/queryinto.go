@@ -0,0 +1,183 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	hdbreflect "github.com/SAP/go-hdb/driver/internal/reflect"
+)
+
+/*
+QueryInto executes query with arguments args and collects the rows column-wise into the typed destination slices dest,
+so that each element of dest is a pointer to a slice receiving the values of the corresponding result column, e.g.
+
+	var ids []int64
+	var names []sql.NullString
+	var amounts []Decimal
+	n, err := driver.QueryInto(ctx, db, []any{&ids, &names, &amounts}, "select id, name, amount from t where id > ?", 0)
+
+The number of destination slices needs to match the number of result columns. The slices are reset (keeping their
+capacity) before collecting the rows and the number of rows is returned.
+The values are converted like for sql.Rows.Scan, so that every slice element type supported as Scan destination
+(including sql.Scanner implementations like Decimal or the sql.Null types) can be used. A conversion error
+is reported with the row and column the conversion failed for; in this case the slices contain the rows collected
+so far.
+*/
+func QueryInto(ctx context.Context, q Queryer, dest []any, query string, args ...any) (int, error) {
+	sliceValues := make([]reflect.Value, len(dest))
+	for i, d := range dest {
+		rv := reflect.ValueOf(d)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+			return 0, fmt.Errorf("invalid destination %d type %T - pointer to slice expected", i, d)
+		}
+		sliceValues[i] = rv.Elem()
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if len(columns) != len(dest) {
+		return 0, fmt.Errorf("number of destinations %d - expected %d (number of columns)", len(dest), len(columns))
+	}
+
+	for _, s := range sliceValues {
+		s.SetLen(0)
+	}
+
+	scanArgs := make([]any, len(dest))
+	numRow := 0
+	for rows.Next() {
+		if err := scanRowInto(rows, sliceValues, scanArgs); err != nil {
+			return numRow, fmt.Errorf("row %d: %w", numRow, err)
+		}
+		numRow++
+	}
+	if err := rows.Err(); err != nil {
+		return numRow, err
+	}
+	return numRow, rows.Close()
+}
+
+// scanRowInto appends the values of the current row to the slices sliceValues.
+func scanRowInto(rows *sql.Rows, sliceValues []reflect.Value, scanArgs []any) error {
+	numRow := sliceValues[0].Len()
+	for i, s := range sliceValues {
+		s.Set(reflect.Append(s, reflect.Zero(s.Type().Elem())))
+		scanArg := s.Index(numRow).Addr().Interface() // scan into slice element
+		// the collected values outlive the rows: read lob content while scanning (see Lob.WriteTo)
+		switch v := scanArg.(type) {
+		case *Lob:
+			v.SetWriter(new(bytes.Buffer))
+		case *NullLob:
+			v.Lob = NewLob(nil, new(bytes.Buffer))
+		}
+		scanArgs[i] = scanArg
+	}
+	if err := rows.Scan(scanArgs...); err != nil {
+		for _, s := range sliceValues { // remove partially scanned row
+			s.SetLen(numRow)
+		}
+		return err
+	}
+	return nil
+}
+
+/*
+QueryColumns executes query with arguments args and returns the complete result column-wise, e.g.
+
+	columns, err := driver.QueryColumns(ctx, db, "select id, name from t")
+	ids := columns[0].([]int32)            // INTEGER NOT NULL
+	names := columns[1].([]sql.NullString) // nullable NVARCHAR
+
+Each element of columns is a slice of the column scan type (see sql.ColumnType.ScanType), so that the values of
+nullable columns are collected as sql.Null types (respectively NullDecimal, NullBytes or NullLob).
+The rows are fetched from the database server in bulk (see WithFetchSize) and converted like in QueryInto.
+
+Please note that the complete result is kept in memory. For large results please use QueryColumnChunks
+instead, which limits the number of rows kept in memory to the chunk size.
+*/
+func QueryColumns(ctx context.Context, q Queryer, query string, args ...any) ([]any, error) {
+	var columns []any
+	fn := func(chunk []any) error { columns = chunk; return nil }
+	if err := QueryColumnChunks(ctx, q, 0, fn, query, args...); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+/*
+QueryColumnChunks executes query with arguments args and calls fn with the result column-wise (see QueryColumns)
+in chunks of at most chunkSize rows (chunkSize <= 0: one chunk containing all rows).
+
+The column slices are reused for the following chunk, so that fn needs to copy the values it wants to retain.
+fn is called at least once (with empty column slices in case of an empty result) and the first error returned
+by fn stops the query and is returned.
+*/
+func QueryColumnChunks(ctx context.Context, q Queryer, chunkSize int, fn func(columns []any) error, query string, args ...any) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	sliceValues := make([]reflect.Value, len(columnTypes))
+	columns := make([]any, len(columnTypes))
+	for i, ct := range columnTypes {
+		scanType := ct.ScanType()
+		if scanType == nil {
+			scanType = hdbreflect.TypeFor[any]()
+		}
+		sliceValues[i] = reflect.New(reflect.SliceOf(scanType)).Elem()
+	}
+
+	callFn := func() error {
+		for i, s := range sliceValues {
+			columns[i] = s.Interface()
+		}
+		if err := fn(columns); err != nil {
+			return err
+		}
+		for _, s := range sliceValues {
+			s.SetLen(0)
+		}
+		return nil
+	}
+
+	scanArgs := make([]any, len(columnTypes))
+	numRow := 0
+	for rows.Next() {
+		if err := scanRowInto(rows, sliceValues, scanArgs); err != nil {
+			return fmt.Errorf("row %d: %w", numRow, err)
+		}
+		numRow++
+		if chunkSize > 0 && numRow%chunkSize == 0 {
+			if err := callFn(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if numRow == 0 || sliceValues[0].Len() != 0 { // empty result or last chunk
+		if err := callFn(); err != nil {
+			return err
+		}
+	}
+	return rows.Close()
+}
@@ -0,0 +1,157 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQueryInto(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	table := RandomIdentifier("queryInto_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, s nvarchar(20), d decimal(10,2), t timestamp, f double)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	const numRow = 3
+	for i := 0; i < numRow; i++ {
+		var s any
+		if i != 1 {
+			s = fmt.Sprintf("row%d", i)
+		}
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?, ?, ?, ?, ?)", table), i, s, fmt.Sprintf("%d.25", i), ts.AddDate(0, 0, i), float64(i)/2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := fmt.Sprintf("select i, s, d, t, f from %s order by i", table)
+
+	is := []int{42} // reset before collecting
+	var ss []sql.NullString
+	var ds []Decimal
+	var tss []time.Time
+	var fs []float64
+	n, err := QueryInto(ctx, db, []any{&is, &ss, &ds, &tss, &fs}, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != numRow {
+		t.Fatalf("number of rows %d - expected %d", n, numRow)
+	}
+	if !slices.Equal(is, []int{0, 1, 2}) {
+		t.Fatalf("integer column %v - expected %v", is, []int{0, 1, 2})
+	}
+	if !slices.Equal(ss, []sql.NullString{{String: "row0", Valid: true}, {}, {String: "row2", Valid: true}}) {
+		t.Fatalf("string column %v", ss)
+	}
+	for i := 0; i < numRow; i++ {
+		if r := (*big.Rat)(&ds[i]); r.Cmp(big.NewRat(int64(i*4+1), 4)) != 0 {
+			t.Fatalf("row %d: decimal %s - expected %d.25", i, r.FloatString(2), i)
+		}
+		if !tss[i].Equal(ts.AddDate(0, 0, i)) {
+			t.Fatalf("row %d: timestamp %s - expected %s", i, tss[i], ts.AddDate(0, 0, i))
+		}
+		if fs[i] != float64(i)/2 {
+			t.Fatalf("row %d: double %f - expected %f", i, fs[i], float64(i)/2)
+		}
+	}
+
+	// conversion error: NULL value into string
+	var is2 []int
+	var ss2 []string
+	var ds2, tss2, fs2 []any
+	n, err = QueryInto(ctx, db, []any{&is2, &ss2, &ds2, &tss2, &fs2}, query)
+	if err == nil || !strings.Contains(err.Error(), "row 1") || !strings.Contains(err.Error(), `"S"`) {
+		t.Fatalf("got error %v - expected conversion error with row and column context", err)
+	}
+	if n != 1 || len(is2) != 1 || len(ss2) != 1 {
+		t.Fatalf("number of rows %d (%d %d) - expected 1", n, len(is2), len(ss2))
+	}
+
+	// invalid destinations
+	if _, err := QueryInto(ctx, db, []any{&is}, query); err == nil {
+		t.Fatal("number of destinations error expected")
+	}
+	if _, err := QueryInto(ctx, db, []any{is, &ss, &ds, &tss, &fs}, query); err == nil {
+		t.Fatal("invalid destination error expected")
+	}
+}
+
+func TestQueryColumns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	table := RandomIdentifier("queryColumns_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer not null, s nvarchar(20), f double not null)", table)); err != nil {
+		t.Fatal(err)
+	}
+	const numRow = 5
+	for i := 0; i < numRow; i++ {
+		var s any
+		if i != 1 {
+			s = fmt.Sprintf("row%d", i)
+		}
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?, ?, ?)", table), i, s, float64(i)/2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := fmt.Sprintf("select i, s, f from %s order by i", table)
+
+	columns, err := QueryColumns(ctx, db, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 3 {
+		t.Fatalf("number of columns %d - expected %d", len(columns), 3)
+	}
+	is, ok := columns[0].([]int32)
+	if !ok || !slices.Equal(is, []int32{0, 1, 2, 3, 4}) {
+		t.Fatalf("integer column %T %v - expected %v", columns[0], columns[0], []int32{0, 1, 2, 3, 4})
+	}
+	ss, ok := columns[1].([]sql.NullString)
+	if !ok || len(ss) != numRow || ss[1].Valid || ss[2] != (sql.NullString{String: "row2", Valid: true}) {
+		t.Fatalf("string column %T %v", columns[1], columns[1])
+	}
+	fs, ok := columns[2].([]float64)
+	if !ok || !slices.Equal(fs, []float64{0, 0.5, 1, 1.5, 2}) {
+		t.Fatalf("double column %T %v - expected %v", columns[2], columns[2], []float64{0, 0.5, 1, 1.5, 2})
+	}
+
+	// chunks
+	var chunkSizes []int
+	var chunkIs []int32
+	if err := QueryColumnChunks(ctx, db, 2, func(columns []any) error {
+		is := columns[0].([]int32)
+		chunkSizes = append(chunkSizes, len(is))
+		chunkIs = append(chunkIs, is...) // copy values
+		return nil
+	}, query); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(chunkSizes, []int{2, 2, 1}) || !slices.Equal(chunkIs, []int32{0, 1, 2, 3, 4}) {
+		t.Fatalf("chunk sizes %v values %v - expected %v %v", chunkSizes, chunkIs, []int{2, 2, 1}, []int32{0, 1, 2, 3, 4})
+	}
+
+	// empty result
+	if columns, err = QueryColumns(ctx, db, fmt.Sprintf("select i from %s where i < 0", table)); err != nil {
+		t.Fatal(err)
+	}
+	if is, ok := columns[0].([]int32); !ok || len(is) != 0 {
+		t.Fatalf("integer column %T %v - expected empty slice", columns[0], columns[0])
+	}
+}
@@ -0,0 +1,36 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// NullRealVector represents a real vector ([]float32) that may be null.
+// NullRealVector implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullRealVector struct {
+	RealVector []float32
+	Valid      bool // Valid is true if RealVector is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullRealVector) Scan(value any) error {
+	if value == nil {
+		n.RealVector, n.Valid = nil, false
+		return nil
+	}
+	v, ok := value.([]float32)
+	if !ok {
+		return fmt.Errorf("invalid real vector type %T", value)
+	}
+	n.RealVector, n.Valid = v, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullRealVector) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.RealVector, nil
+}
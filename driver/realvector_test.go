@@ -0,0 +1,76 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+func TestRealVector(t *testing.T) {
+	t.Parallel()
+
+	connector := MT.NewConnector()
+	connector.SetDfv(p.DfvLevel10)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	table := RandomIdentifier("realVector_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, v real_vector(3))", table)); err != nil {
+		t.Skipf("real vector not supported: %s", err)
+	}
+
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values (?, ?)", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+
+	tests := []struct {
+		in  any
+		out []float32
+	}{
+		{[]float32{1.5, -2, 3}, []float32{1.5, -2, 3}},
+		{[]float64{0.25, 0.5, 0.75}, []float32{0.25, 0.5, 0.75}},
+		{nil, nil},
+	}
+	for i, test := range tests {
+		if _, err := stmt.Exec(i, test.in); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// dimension mismatch
+	if _, err := stmt.Exec(len(tests), []float32{1, 2}); err == nil || !strings.Contains(err.Error(), "dimension mismatch") {
+		t.Fatalf("got error %v - expected dimension mismatch error", err)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("select v from %s order by i", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	i := 0
+	for rows.Next() {
+		var v NullRealVector
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(v.RealVector, tests[i].out) || v.Valid != (tests[i].out != nil) {
+			t.Fatalf("row %d: value %v - expected %v", i, v.RealVector, tests[i].out)
+		}
+		i++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(tests) {
+		t.Fatalf("number of rows %d - expected %d", i, len(tests))
+	}
+}
@@ -0,0 +1,94 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+/*
+ReconnectPolicy defines how a connector re-establishes connections in case of connection errors
+(see Connector.SetReconnectPolicy and IsConnectionError).
+
+Connecting is retried up to MaxAttempts times in case of a connection error (e.g. while the database server
+is restarting). The wait time before the first retry is Backoff and is doubled for each further retry
+limited by MaxBackoff (if set).
+*/
+type ReconnectPolicy struct {
+	MaxAttempts int           // maximum number of reconnect attempts (<= 0: reconnect disabled)
+	Backoff     time.Duration // wait time before the first reconnect attempt
+	MaxBackoff  time.Duration // maximum wait time between reconnect attempts (0: no limit)
+}
+
+func (rp ReconnectPolicy) enabled() bool { return rp.MaxAttempts > 0 }
+
+// backoff returns the wait time before reconnect attempt (starting with 0).
+func (rp ReconnectPolicy) backoff(attempt int) time.Duration {
+	d := rp.Backoff
+	for i := 0; i < attempt && d < math.MaxInt64/2 && (rp.MaxBackoff == 0 || d < rp.MaxBackoff); i++ {
+		d *= 2
+	}
+	if rp.MaxBackoff != 0 && d > rp.MaxBackoff {
+		return rp.MaxBackoff
+	}
+	return d
+}
+
+// reconnect calls connect and retries in case of connection errors according to the reconnect policy rp.
+func reconnect(ctx context.Context, rp ReconnectPolicy, connect func(ctx context.Context) (driver.Conn, error)) (driver.Conn, error) {
+	conn, err := connect(ctx)
+	for attempt := 0; attempt < rp.MaxAttempts && err != nil && IsConnectionError(err); attempt++ {
+		timer := time.NewTimer(rp.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+		conn, err = connect(ctx)
+	}
+	return conn, err
+}
+
+/*
+retryError prepares the connection error err of a request for the retry handling of database/sql in case a
+reconnect policy is set:
+  - err is wrapped in driver.ErrBadConn, so that database/sql executes the statement again on a new connection,
+    if the connection is not within a transaction and the request was not sent to the database server or is a
+    query reading data only (readOnly)
+  - otherwise driver.ErrBadConn is hidden from database/sql, as the database server might have executed the
+    statement already (e.g. DML executed via Query) and a retry would duplicate its effects.
+*/
+func (c *conn) retryError(err error, sent, readOnly bool) error {
+	if err == nil || !c.attrs._reconnectPolicy.enabled() || !IsConnectionError(err) {
+		return err
+	}
+	if !c.inTx && (!sent || readOnly) {
+		if errors.Is(err, driver.ErrBadConn) {
+			return err
+		}
+		return fmt.Errorf("%w: %w", driver.ErrBadConn, err)
+	}
+	if !errors.Is(err, driver.ErrBadConn) {
+		return err
+	}
+	return &noRetryError{err: err}
+}
+
+// noRetryError hides driver.ErrBadConn of a connection error from database/sql to prevent a statement retry.
+type noRetryError struct {
+	err error
+}
+
+func (e *noRetryError) Error() string { return e.err.Error() }
+
+// Is reports whether err matches target except for driver.ErrBadConn.
+func (e *noRetryError) Is(target error) bool {
+	return target != driver.ErrBadConn && errors.Is(e.err, target)
+}
+
+// As finds the first error in err's tree that matches target.
+func (e *noRetryError) As(target any) bool { return errors.As(e.err, target) }
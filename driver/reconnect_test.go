@@ -0,0 +1,234 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+func TestReconnectPolicyBackoff(t *testing.T) {
+	policy := ReconnectPolicy{MaxAttempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for attempt, d := range expected {
+		if backoff := policy.backoff(attempt); backoff != d {
+			t.Fatalf("attempt %d: backoff %s - expected %s", attempt, backoff, d)
+		}
+	}
+	policy.MaxBackoff = 0 // no limit
+	if backoff := policy.backoff(100); backoff <= 0 {
+		t.Fatalf("backoff %s - expected positive duration", backoff)
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	connErr := fmt.Errorf("%w: %w", driver.ErrBadConn, errors.New("connection reset by peer"))
+	otherErr := errors.New("invalid host")
+
+	policy := ReconnectPolicy{MaxAttempts: 3}
+
+	tests := []struct {
+		errs       []error // errors returned by connect per attempt
+		numConnect int
+		successful bool
+	}{
+		{nil, 1, true},
+		{[]error{connErr, connErr}, 3, true},
+		{[]error{connErr, connErr, connErr, connErr}, 4, false}, // max attempts exceeded
+		{[]error{otherErr}, 1, false},                           // no connection error
+	}
+
+	for i, test := range tests {
+		numConnect := 0
+		_, err := reconnect(context.Background(), policy, func(ctx context.Context) (driver.Conn, error) {
+			numConnect++
+			if numConnect <= len(test.errs) {
+				return nil, test.errs[numConnect-1]
+			}
+			return nil, nil
+		})
+		if numConnect != test.numConnect || (err == nil) != test.successful {
+			t.Fatalf("test %d: number of connects %d error %v - expected %d successful %t", i, numConnect, err, test.numConnect, test.successful)
+		}
+	}
+
+	// cancellation during backoff
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := reconnect(ctx, ReconnectPolicy{MaxAttempts: 3, Backoff: time.Hour}, func(ctx context.Context) (driver.Conn, error) { return nil, connErr })
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, driver.ErrBadConn) {
+		t.Fatalf("got error %v - expected connection and cancellation error", err)
+	}
+}
+
+func TestRetryError(t *testing.T) {
+	netErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	otherErr := errors.New("invalid argument")
+	badConnErr := fmt.Errorf("%w: %w", driver.ErrBadConn, io.EOF) // read error
+
+	tests := []struct {
+		policy   ReconnectPolicy
+		sent     bool
+		readOnly bool
+		inTx     bool
+		err      error
+		retry    bool
+	}{
+		{ReconnectPolicy{}, true, true, false, netErr, false}, // no policy
+		{ReconnectPolicy{MaxAttempts: 1}, true, true, false, netErr, true},
+		{ReconnectPolicy{MaxAttempts: 1}, false, false, false, netErr, true},     // request not sent
+		{ReconnectPolicy{MaxAttempts: 1}, true, false, false, netErr, false},     // exec or dml via query
+		{ReconnectPolicy{MaxAttempts: 1}, true, false, false, badConnErr, false}, // exec or dml via query
+		{ReconnectPolicy{MaxAttempts: 1}, true, true, true, netErr, false},       // transaction
+		{ReconnectPolicy{MaxAttempts: 1}, true, true, false, otherErr, false},
+	}
+
+	for i, test := range tests {
+		c := &conn{attrs: &connAttrs{_reconnectPolicy: test.policy}, inTx: test.inTx}
+		err := c.retryError(test.err, test.sent, test.readOnly)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("test %d: error %v does not wrap %v", i, err, test.err)
+		}
+		if retry := errors.Is(err, driver.ErrBadConn); retry != test.retry {
+			t.Fatalf("test %d: retry %t - expected %t", i, retry, test.retry)
+		}
+		if IsConnectionError(test.err) && !IsConnectionError(err) {
+			t.Fatalf("test %d: connection error expected", i)
+		}
+	}
+
+	dbErr := &testDBError{code: 1, level: HdbError, text: "general error"}
+	dbConnErr := &testDBError{code: 1, level: HdbFatalError, text: "general error", connection: true}
+
+	for _, err := range []error{errCancelled, context.Canceled, otherErr, dbErr} {
+		if IsConnectionError(err) {
+			t.Fatalf("%v: no connection error expected", err)
+		}
+	}
+	for _, err := range []error{netErr, driver.ErrBadConn, dbConnErr, fmt.Errorf("exec: %w", dbConnErr)} {
+		if !IsConnectionError(err) {
+			t.Fatalf("%v: connection error expected", err)
+		}
+	}
+}
+
+func TestReconnectRetry(t *testing.T) {
+	t.Parallel()
+
+	const (
+		insertQuery = "insert into t values (1)"
+		selectQuery = "select * from t"
+	)
+
+	var numInsert, numSelect atomic.Int32
+	srv := newMockServer(t, func(req *mockRequest) *mockReply {
+		switch req.command() {
+		case insertQuery:
+			numInsert.Add(1)
+			return nil // connection lost after the statement got executed
+		case selectQuery:
+			if numSelect.Add(1) == 1 {
+				return nil // connection lost
+			}
+		}
+		return &mockReply{}
+	})
+
+	connector := NewJWTAuthConnector(srv.host(), "token")
+	connector.SetReconnectPolicy(ReconnectPolicy{MaxAttempts: 1})
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	// dml executed via query must not be retried.
+	rows, err := db.Query(insertQuery)
+	if err == nil {
+		rows.Close()
+		t.Fatal("error expected")
+	}
+	if !IsConnectionError(err) {
+		t.Fatalf("got error %v - expected connection error", err)
+	}
+	if n := numInsert.Load(); n != 1 {
+		t.Fatalf("number of inserts %d - expected %d", n, 1)
+	}
+	// dml executed via exec must not be retried.
+	if _, err := db.Exec(insertQuery); !IsConnectionError(err) {
+		t.Fatalf("got error %v - expected connection error", err)
+	}
+	if n := numInsert.Load(); n != 2 {
+		t.Fatalf("number of inserts %d - expected %d", n, 2)
+	}
+
+	// select is retried on a new connection.
+	rows, err = db.Query(selectQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+	if n := numSelect.Load(); n != 2 {
+		t.Fatalf("number of selects %d - expected %d", n, 2)
+	}
+}
+
+func TestRedirectHook(t *testing.T) {
+	t.Parallel()
+
+	type redirect struct{ from, to string }
+
+	newTenantServer := func() *mockServer {
+		return newMockServer(t, func(req *mockRequest) *mockReply { return &mockReply{} })
+	}
+	tenant1, tenant2 := newTenantServer(), newTenantServer()
+
+	var tenantHost atomic.Value // host of the tenant database
+	tenantHost.Store(tenant1.host())
+	system := newMockServer(t, func(req *mockRequest) *mockReply {
+		if req.messageType == p.MtDBConnectInfo {
+			return &mockReply{parts: []mockPart{mockDBConnectInfoPart(tenantHost.Load().(string))}}
+		}
+		return &mockReply{}
+	})
+
+	var mu sync.Mutex
+	var redirects []redirect
+
+	connector := NewJWTAuthConnector(system.host(), "token").WithDatabase("TENANT")
+	connector.SetReconnectPolicy(ReconnectPolicy{MaxAttempts: 1})
+	connector.SetRedirectHook(func(from, to string) {
+		mu.Lock()
+		defer mu.Unlock()
+		redirects = append(redirects, redirect{from: from, to: to})
+	})
+
+	connect := func() {
+		conn, err := connector.Connect(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	}
+
+	connect() // tenant redirect
+	connect() // same tenant host: no redirect
+	// failover: tenant database moves to tenant2
+	tenant1.close()
+	tenantHost.Store(tenant2.host())
+	connect()
+
+	expected := []redirect{{from: system.host(), to: tenant1.host()}, {from: tenant1.host(), to: tenant2.host()}}
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(redirects, expected) {
+		t.Fatalf("redirects %v - expected %v", redirects, expected)
+	}
+}
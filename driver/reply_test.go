@@ -0,0 +1,67 @@
+package driver
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+func TestLoadUnloadReply(t *testing.T) {
+	t.Parallel()
+
+	const warningCode = 4711
+
+	// minimal replies of LOAD and UNLOAD statements.
+	replies := map[string]*mockReply{
+		// no parts
+		"unload t": {functionCode: p.FcDDL},
+		// rows affected: success without info
+		"load t all": {parts: []mockPart{mockRowsAffectedPart(-2)}},
+		// warning only
+		"unload t delete persistent memory": {functionCode: p.FcDDL, parts: []mockPart{mockWarningPart(warningCode, "warning")}},
+	}
+
+	srv := newMockServer(t, func(req *mockRequest) *mockReply {
+		if reply, ok := replies[req.command()]; ok {
+			return reply
+		}
+		return &mockReply{}
+	})
+
+	var mu sync.Mutex
+	var warnings []DBError
+
+	connector := NewJWTAuthConnector(srv.host(), "token")
+	connector.SetWarningHandler(func(warning DBError) {
+		mu.Lock()
+		defer mu.Unlock()
+		warnings = append(warnings, warning)
+	})
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	for query := range replies {
+		if _, err := db.Exec(query); err != nil {
+			t.Fatalf("%s: %s", query, err)
+		}
+	}
+
+	result, err := db.Exec("load t all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows, err := result.RowsAffected(); err != nil || rows != 0 {
+		t.Fatalf("rows affected %d error %v - expected %d", rows, err, 0)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(warnings) != 1 {
+		t.Fatalf("number of warnings %d - expected %d", len(warnings), 1)
+	}
+	if !warnings[0].IsWarning() || warnings[0].Code() != warningCode {
+		t.Fatalf("warning %v - expected warning with code %d", warnings[0], warningCode)
+	}
+}
@@ -0,0 +1,80 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestReprepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	table := RandomIdentifier("reprepare_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, j integer)", table)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (1, 2)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	queryStmt, err := conn.PrepareContext(ctx, fmt.Sprintf("select * from %s where i = ?", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer queryStmt.Close()
+
+	execStmt, err := conn.PrepareContext(ctx, fmt.Sprintf("update %s set j = ? where i = ?", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer execStmt.Close()
+
+	numColumn := func() int {
+		rows, err := queryStmt.QueryContext(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		columns, err := rows.Columns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for rows.Next() {
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return len(columns)
+	}
+
+	if n := numColumn(); n != 2 {
+		t.Fatalf("number of columns %d - expected %d", n, 2)
+	}
+
+	// alter table between prepare and execute
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("alter table %s add (k nvarchar(10))", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := numColumn(); n != 3 {
+		t.Fatalf("number of columns %d - expected %d", n, 3)
+	}
+	result, err := execStmt.ExecContext(ctx, 3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows, err := result.RowsAffected(); err != nil || rows != 1 {
+		t.Fatalf("rows affected %d error %v - expected %d", rows, err, 1)
+	}
+}
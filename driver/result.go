@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	p "github.com/SAP/go-hdb/driver/internal/protocol"
+	"golang.org/x/text/encoding"
 )
 
 // check if rows types do implement all driver row interfaces.
@@ -26,9 +27,18 @@ var (
 	*/
 
 	_ driver.Rows = (*callResult)(nil)
+
+	_ driver.Rows                           = (*callResultSets)(nil)
+	_ driver.RowsNextResultSet              = (*callResultSets)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*callResultSets)(nil)
+	_ driver.RowsColumnTypeLength           = (*callResultSets)(nil)
+	_ driver.RowsColumnTypeNullable         = (*callResultSets)(nil)
+	_ driver.RowsColumnTypePrecisionScale   = (*callResultSets)(nil)
+	_ driver.RowsColumnTypeScanType         = (*callResultSets)(nil)
 )
 
 type prepareResult struct {
+	query           string
 	fc              p.FunctionCode
 	stmtID          uint64
 	parameterFields []*p.ParameterField
@@ -38,6 +48,9 @@ type prepareResult struct {
 // isProcedureCall returns true if the statement is a call statement.
 func (pr *prepareResult) isProcedureCall() bool { return pr.fc.IsProcedureCall() }
 
+// isSelect returns true if the statement is a select statement.
+func (pr *prepareResult) isSelect() bool { return pr.fc.IsSelect() }
+
 // numField returns the number of parameter fields in a database statement.
 func (pr *prepareResult) numField() int { return len(pr.parameterFields) }
 
@@ -64,6 +77,10 @@ type queryResult struct {
 	rsID         uint64
 	pos          int
 	attrs        p.PartAttributes
+	lobIDs       []p.LocatorID     // lob locators opened by this result
+	ctx          context.Context   // statement context (lob reads)
+	lobEncoding  encoding.Encoding // clob encoding (see WithLobEncoding)
+	valueBuf     []byte            // buffer for variable length field values of fetched rows (nil: values are allocated)
 }
 
 // Columns implements the driver.Rows interface.
@@ -80,6 +97,19 @@ func (qr *queryResult) Columns() []string {
 
 // Close implements the driver.Rows interface.
 func (qr *queryResult) Close() error {
+	if err := qr.conn.lock(); err != nil {
+		return err
+	}
+	defer qr.conn.unlock()
+	return qr.close()
+}
+
+// close closes the result set (the connection needs to be locked by the caller).
+func (qr *queryResult) close() error {
+	for _, id := range qr.lobIDs {
+		qr.conn.closeLob(id)
+	}
+	qr.lobIDs = nil
 	if qr.attrs.ResultsetClosed() {
 		return nil
 	}
@@ -90,6 +120,17 @@ func (qr *queryResult) Close() error {
 	return qr.conn.closeResultsetID(context.Background(), qr.rsID)
 }
 
+/*
+reuseValueBuffer enables decoding the variable length field values (e.g. character and binary values) of all
+subsequently fetched rows into one buffer, which is reused for each fetch. Therefore the values of a row
+are only valid until the next call of Next.
+*/
+func (qr *queryResult) reuseValueBuffer() {
+	if qr.valueBuf == nil {
+		qr.valueBuf = []byte{}
+	}
+}
+
 func (qr *queryResult) numRow() int {
 	if len(qr.fieldValues) == 0 {
 		return 0
@@ -104,6 +145,11 @@ func (qr *queryResult) copyRow(idx int, dest []driver.Value) {
 
 // Next implements the driver.Rows interface.
 func (qr *queryResult) Next(dest []driver.Value) error {
+	if err := qr.conn.lock(); err != nil {
+		return err
+	}
+	defer qr.conn.unlock()
+
 	if qr.pos >= qr.numRow() {
 		if qr.attrs.LastPacket() {
 			return io.EOF
@@ -123,8 +169,14 @@ func (qr *queryResult) Next(dest []driver.Value) error {
 	qr.pos++
 
 	for _, v := range dest {
-		if v, ok := v.(p.LobDecoderSetter); ok {
-			v.SetDecoder(qr.conn.decodeLob)
+		if descr, ok := v.(*p.LobOutDescr); ok {
+			registered, err := qr.conn.openLob(qr.ctx, descr, qr.lobEncoding)
+			if err != nil {
+				return err
+			}
+			if registered {
+				qr.lobIDs = append(qr.lobIDs, descr.ID)
+			}
 		}
 	}
 	return err
@@ -158,6 +210,9 @@ type callResult struct { // call output parameters
 	decodeErrors p.DecodeErrors
 	_columns     []string
 	eof          bool
+	lobIDs       []p.LocatorID     // lob locators opened by this result
+	ctx          context.Context   // statement context (lob reads)
+	lobEncoding  encoding.Encoding // clob encoding (see WithLobEncoding)
 }
 
 // Columns implements the driver.Rows interface.
@@ -174,6 +229,11 @@ func (cr *callResult) Columns() []string {
 
 // Next implements the driver.Rows interface.
 func (cr *callResult) Next(dest []driver.Value) error {
+	if err := cr.conn.lock(); err != nil {
+		return err
+	}
+	defer cr.conn.unlock()
+
 	if len(cr.fieldValues) == 0 || cr.eof {
 		return io.EOF
 	}
@@ -182,12 +242,133 @@ func (cr *callResult) Next(dest []driver.Value) error {
 	err := cr.decodeErrors.RowError(0)
 	cr.eof = true
 	for _, v := range dest {
-		if v, ok := v.(p.LobDecoderSetter); ok {
-			v.SetDecoder(cr.conn.decodeLob)
+		if descr, ok := v.(*p.LobOutDescr); ok {
+			registered, err := cr.conn.openLob(cr.ctx, descr, cr.lobEncoding)
+			if err != nil {
+				return err
+			}
+			if registered {
+				cr.lobIDs = append(cr.lobIDs, descr.ID)
+			}
+		}
+	}
+	return err
+}
+
+// Close implements the driver.Rows interface.
+func (cr *callResult) Close() error {
+	if err := cr.conn.lock(); err != nil {
+		return err
+	}
+	defer cr.conn.unlock()
+
+	for _, id := range cr.lobIDs {
+		cr.conn.closeLob(id)
+	}
+	cr.lobIDs = nil
+	return nil
+}
+
+/*
+callResultSets represents the result sets of a queried procedure call in procedure order.
+The scalar output parameters are assigned (assignOut) exactly once, after the last result set is consumed
+or when the rows are closed.
+*/
+type callResultSets struct {
+	results   []*queryResult
+	idx       int
+	assignOut func() error
+	assigned  bool
+}
+
+func (rs *callResultSets) assign() error {
+	if rs.assigned || rs.assignOut == nil {
+		return nil
+	}
+	rs.assigned = true
+	return rs.assignOut()
+}
+
+// Columns implements the driver.Rows interface.
+func (rs *callResultSets) Columns() []string {
+	if rs.idx >= len(rs.results) {
+		return noColumns
+	}
+	return rs.results[rs.idx].Columns()
+}
+
+// Next implements the driver.Rows interface.
+func (rs *callResultSets) Next(dest []driver.Value) error {
+	if rs.idx >= len(rs.results) {
+		if err := rs.assign(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	err := rs.results[rs.idx].Next(dest)
+	if err == io.EOF && rs.idx == len(rs.results)-1 { // last result set consumed
+		if err := rs.assign(); err != nil {
+			return err
 		}
 	}
 	return err
 }
 
 // Close implements the driver.Rows interface.
-func (cr *callResult) Close() error { return nil }
+func (rs *callResultSets) Close() error {
+	var err error
+	for ; rs.idx < len(rs.results); rs.idx++ {
+		if closeErr := rs.results[rs.idx].Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	if assignErr := rs.assign(); assignErr != nil && err == nil {
+		err = assignErr
+	}
+	return err
+}
+
+// HasNextResultSet implements the driver.RowsNextResultSet interface.
+func (rs *callResultSets) HasNextResultSet() bool { return rs.idx+1 < len(rs.results) }
+
+// NextResultSet implements the driver.RowsNextResultSet interface.
+func (rs *callResultSets) NextResultSet() error {
+	if rs.idx < len(rs.results) {
+		if err := rs.results[rs.idx].Close(); err != nil {
+			return err
+		}
+		rs.idx++
+	}
+	if rs.idx >= len(rs.results) {
+		if err := rs.assign(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	return nil
+}
+
+// ColumnTypeDatabaseTypeName implements the driver.RowsColumnTypeDatabaseTypeName interface.
+func (rs *callResultSets) ColumnTypeDatabaseTypeName(idx int) string {
+	return rs.results[rs.idx].ColumnTypeDatabaseTypeName(idx)
+}
+
+// ColumnTypeLength implements the driver.RowsColumnTypeLength interface.
+func (rs *callResultSets) ColumnTypeLength(idx int) (int64, bool) {
+	return rs.results[rs.idx].ColumnTypeLength(idx)
+}
+
+// ColumnTypeNullable implements the driver.RowsColumnTypeNullable interface.
+func (rs *callResultSets) ColumnTypeNullable(idx int) (bool, bool) {
+	return rs.results[rs.idx].ColumnTypeNullable(idx)
+}
+
+// ColumnTypePrecisionScale implements the driver.RowsColumnTypePrecisionScale interface.
+func (rs *callResultSets) ColumnTypePrecisionScale(idx int) (int64, int64, bool) {
+	return rs.results[rs.idx].ColumnTypePrecisionScale(idx)
+}
+
+// ColumnTypeScanType implements the driver.RowsColumnTypeScanType interface.
+func (rs *callResultSets) ColumnTypeScanType(idx int) reflect.Type {
+	return rs.results[rs.idx].ColumnTypeScanType(idx)
+}
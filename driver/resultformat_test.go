@@ -0,0 +1,112 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const (
+	testResultFormatNumCol = 50
+	testResultFormatNumRow = 1000
+)
+
+var testResultFormats = []struct {
+	name   string
+	format ResultFormat
+}{
+	{"row", RowResultFormat},
+	{"columnar", ColumnarResultFormat},
+}
+
+// testResultFormatTable creates a wide table with numCol integer columns and numRow rows.
+func testResultFormatTable(tb testing.TB, db *sql.DB, numCol, numRow int) Identifier {
+	tableName := RandomIdentifier("resultFormat_")
+
+	cols := make([]string, numCol)
+	vals := make([]string, numCol)
+	for i := 0; i < numCol; i++ {
+		cols[i] = fmt.Sprintf("c%d integer", i)
+		vals[i] = fmt.Sprintf("generated_period_start + %d", i)
+	}
+	if _, err := db.Exec(fmt.Sprintf("create table %s (%s)", tableName, strings.Join(cols, ", "))); err != nil {
+		tb.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s select %s from series_generate_integer(1, 0, %d)", tableName, strings.Join(vals, ", "), numRow)); err != nil {
+		tb.Fatal(err)
+	}
+	return tableName
+}
+
+func testResultFormatDB(format ResultFormat) *sql.DB {
+	connector := MT.NewConnector()
+	connector.SetResultFormat(format)
+	return sql.OpenDB(connector)
+}
+
+func testResultFormatQuery(tb testing.TB, db *sql.DB, query string, numCol int) int {
+	rows, err := db.Query(query)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer rows.Close()
+
+	values := make([]int, numCol)
+	dest := make([]any, numCol)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	numRow := 0
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			tb.Fatal(err)
+		}
+		for i, v := range values {
+			if v != values[0]+i {
+				tb.Fatalf("row %d column %d: value %d - expected %d", numRow, i, v, values[0]+i)
+			}
+		}
+		numRow++
+	}
+	if err := rows.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return numRow
+}
+
+func TestResultFormat(t *testing.T) {
+	t.Parallel()
+
+	tableName := testResultFormatTable(t, MT.DB(), testResultFormatNumCol, testResultFormatNumRow)
+	query := fmt.Sprintf("select * from %s order by c0", tableName)
+
+	for _, rf := range testResultFormats {
+		t.Run(rf.name, func(t *testing.T) {
+			db := testResultFormatDB(rf.format)
+			defer db.Close()
+
+			if numRow := testResultFormatQuery(t, db, query, testResultFormatNumCol); numRow != testResultFormatNumRow {
+				t.Fatalf("number of rows %d - expected %d", numRow, testResultFormatNumRow)
+			}
+		})
+	}
+}
+
+func BenchmarkResultFormat(b *testing.B) {
+	tableName := testResultFormatTable(b, MT.DB(), testResultFormatNumCol, testResultFormatNumRow)
+	query := fmt.Sprintf("select * from %s", tableName)
+
+	for _, rf := range testResultFormats {
+		b.Run(rf.name, func(b *testing.B) {
+			db := testResultFormatDB(rf.format)
+			defer db.Close()
+
+			for i := 0; i < b.N; i++ {
+				testResultFormatQuery(b, db, query, testResultFormatNumCol)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+//go:build !unit && go1.23
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func TestRows(t *testing.T) {
+	t.Parallel()
+
+	const numRow = 10
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	table := RandomIdentifier("rows_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, s nvarchar(10))", table)); err != nil {
+		t.Fatal(err)
+	}
+	for i := range numRow {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?, ?)", table), i, fmt.Sprintf("s%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// checkClosed verifies that the connection is not used by an open iterator anymore.
+	checkClosed := func(t *testing.T) {
+		var cnt int
+		if err := conn.QueryRowContext(ctx, fmt.Sprintf("select count(*) from %s", table)).Scan(&cnt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("full", func(t *testing.T) {
+		i := 0
+		for values, err := range Rows(ctx, conn, fmt.Sprintf("select i, s from %s order by i", table)) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			s, ok := values[1].([]byte) // character values are returned as []byte
+			if values[0] != int64(i) || !ok || string(s) != fmt.Sprintf("s%d", i) {
+				t.Fatalf("invalid values %v - expected %d s%d", values, i, i)
+			}
+			i++
+		}
+		if i != numRow {
+			t.Fatalf("number of rows %d - expected %d", i, numRow)
+		}
+		checkClosed(t)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		i := 0
+		for _, err := range Rows(ctx, conn, fmt.Sprintf("select i from %s where i >= ? order by i", table), 2) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			i++
+			if i == 3 {
+				break
+			}
+		}
+		if i != 3 {
+			t.Fatalf("number of rows %d - expected %d", i, 3)
+		}
+		checkClosed(t)
+	})
+
+	t.Run("named", func(t *testing.T) {
+		i := 0
+		for values, err := range Rows(ctx, conn, fmt.Sprintf("select s from %s where i = :i", table), sql.Named("i", 5)) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s, ok := values[0].([]byte); !ok || string(s) != "s5" {
+				t.Fatalf("invalid value %v - expected %s", values[0], "s5")
+			}
+			i++
+		}
+		if i != 1 {
+			t.Fatalf("number of rows %d - expected %d", i, 1)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		for _, err := range Rows(ctx, conn, "select * from not_existing_table") {
+			if err == nil {
+				t.Fatal("error expected")
+			}
+		}
+		checkClosed(t)
+	})
+}
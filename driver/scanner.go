@@ -1,12 +1,18 @@
 package driver
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/SAP/go-hdb/driver/internal/protocol/levenshtein"
 	hdbreflect "github.com/SAP/go-hdb/driver/internal/reflect"
 	"github.com/SAP/go-hdb/driver/internal/unsafe"
 )
@@ -203,13 +209,155 @@ func (sc StructScanner[S]) Scan(rows *sql.Rows, s *S) error {
 	for i, name := range columns {
 		column, ok := sc.nameColumnMap[name]
 		if !ok {
-			return fmt.Errorf("field for column name %s not found", name)
+			if len(sc.columns) == 0 {
+				return fmt.Errorf("field for column name %s not found", name)
+			}
+			return fmt.Errorf("field for column name %s not found - did you mean %s?",
+				name,
+				levenshtein.MinString(sc.columns, func(column *structColumn) string { return column.Name() }, name, false),
+			)
 		}
 		values[i] = rv.FieldByIndex(column.fieldIndex).Addr().Interface()
 	}
 	return rows.Scan(values...)
 }
 
+/*
+ScanSlice executes the single column query with arguments args on connection sqlConn and appends the values
+of all rows to slice dest, so a preallocated slice avoids reallocation. This is a convenience function for
+queries like 'select id from ...'.
+
+In contrast to a rows.Scan loop the rows are read from the driver rows directly into a single reused value
+(see Rows), which avoids the per row overhead of sql.Rows.Next and sql.Rows.Scan. Values of type T are
+assigned as they are, other values are converted for destinations implementing sql.Scanner, for numeric,
+boolean, string and []byte destinations and pointers to those. The connection is exclusively used by
+ScanSlice until it returns.
+*/
+func ScanSlice[T any](ctx context.Context, sqlConn *sql.Conn, query string, dest *[]T, args ...any) error {
+	return rawConn(sqlConn, func(c *conn) error {
+		rows, closeStmt, err := c.queryRows(ctx, query, args)
+		if err != nil {
+			return err
+		}
+		defer closeStmt()
+
+		err = scanSliceRows(rows, dest)
+		if closeErr := rows.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	})
+}
+
+func scanSliceRows[T any](rows driver.Rows, dest *[]T) error {
+	if numColumn := len(rows.Columns()); numColumn != 1 {
+		return fmt.Errorf("invalid number of result columns %d - expected 1", numColumn)
+	}
+	if qr, ok := rows.(*queryResult); ok {
+		qr.reuseValueBuffer()
+	}
+	values := make([]driver.Value, 1)
+	for {
+		if err := rows.Next(values); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		var v T // new scan target per row, so that values do not share state (e.g. Lob writers)
+		if err := scanValue(values[0], &v); err != nil {
+			return fmt.Errorf("row %d: %w", len(*dest), err)
+		}
+		*dest = append(*dest, v)
+	}
+}
+
+// scanValue assigns the driver value v to dest.
+func scanValue[T any](v driver.Value, dest *T) error {
+	if b, ok := v.([]byte); ok { // value buffer is reused
+		if _, ok := any(dest).(*string); ok {
+			*dest = any(string(b)).(T)
+			return nil
+		}
+		v = bytes.Clone(b)
+	}
+	if scanner, ok := any(dest).(sql.Scanner); ok {
+		return scanner.Scan(v)
+	}
+	if v, ok := v.(T); ok {
+		*dest = v
+		return nil
+	}
+	dv := reflect.ValueOf(dest).Elem()
+	if v == nil {
+		switch dv.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			dv.SetZero()
+			return nil
+		default:
+			return fmt.Errorf("converting NULL to %s is unsupported", dv.Type())
+		}
+	}
+	if dv.Kind() == reflect.Pointer {
+		pv := reflect.New(dv.Type().Elem())
+		if err := convertScanValue(reflect.ValueOf(v), pv.Elem()); err != nil {
+			return err
+		}
+		dv.Set(pv)
+		return nil
+	}
+	return convertScanValue(reflect.ValueOf(v), dv)
+}
+
+// convertScanValue converts the driver value rv to dv.
+func convertScanValue(rv, dv reflect.Value) error {
+	if rv.Type().AssignableTo(dv.Type()) {
+		dv.Set(rv)
+		return nil
+	}
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.CanInt() && !dv.OverflowInt(rv.Int()) {
+			dv.SetInt(rv.Int())
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.CanInt() && rv.Int() >= 0 && !dv.OverflowUint(uint64(rv.Int())) {
+			dv.SetUint(uint64(rv.Int()))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case rv.CanFloat() && !dv.OverflowFloat(rv.Float()):
+			dv.SetFloat(rv.Float())
+			return nil
+		case rv.CanInt():
+			dv.SetFloat(float64(rv.Int()))
+			return nil
+		}
+	case reflect.Bool:
+		if rv.Kind() == reflect.Bool {
+			dv.SetBool(rv.Bool())
+			return nil
+		}
+	case reflect.String:
+		switch {
+		case rv.Kind() == reflect.String:
+			dv.SetString(rv.String())
+			return nil
+		case rv.Type() == bytesType:
+			dv.SetString(string(rv.Bytes()))
+			return nil
+		}
+	case reflect.Slice:
+		if dv.Type() == bytesType && rv.Kind() == reflect.String {
+			dv.SetBytes([]byte(rv.String()))
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported conversion of value %v of type %s to %s", rv, rv.Type(), dv.Type())
+}
+
 // columnDefs returns the column definitions for a sql create statement.
 // experimental: before 'export' completion of inferSQLType is needed
 func (sc StructScanner[S]) columnDefs() (string, error) { return sc.columns.defs() }
@@ -249,6 +397,8 @@ var (
 	nullBytesType   = hdbreflect.TypeFor[NullBytes]()
 	nullDecimalType = hdbreflect.TypeFor[NullDecimal]()
 	nullLobType     = hdbreflect.TypeFor[NullLob]()
+	pointType       = hdbreflect.TypeFor[Point]()
+	nullPointType   = hdbreflect.TypeFor[NullPoint]()
 )
 
 var typeSQLDatatypes = map[reflect.Type]string{
@@ -267,6 +417,8 @@ var typeSQLDatatypes = map[reflect.Type]string{
 	nullBytesType:   "varchar(256)",
 	nullDecimalType: "decimal",
 	nullLobType:     "blob",
+	pointType:       "st_point",
+	nullPointType:   "st_point",
 }
 
 // inferSQLDatatype tries to infer the hdb sql datatype.
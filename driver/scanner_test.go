@@ -3,6 +3,8 @@
 package driver
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 )
@@ -85,12 +87,28 @@ func TestScanStruct(t *testing.T) {
 		return nil
 	}
 
+	testScanStructUnknownColumn := func() error {
+		row := new(testScanRow)
+
+		rows, err := db.Query(fmt.Sprintf(`select "s" as ss, "i", "C", "x" from %s`, tableName))
+		if err != nil {
+			return err
+		}
+
+		const expected = "field for column name SS not found - did you mean s?"
+		if err := scanner.ScanRow(rows, row); err == nil || err.Error() != expected {
+			return fmt.Errorf("got error %v - expected %s", err, expected)
+		}
+		return nil
+	}
+
 	tests := []struct {
 		name string
 		fn   func() error
 	}{
 		{"testScanStructRows", testScanStructRows},
 		{"testScanStructRow", testScanStructRow},
+		{"testScanStructUnknownColumn", testScanStructUnknownColumn},
 	}
 
 	for _, test := range tests {
@@ -104,3 +122,115 @@ func TestScanStruct(t *testing.T) {
 		})
 	}
 }
+
+func testScanSliceTable(tb testing.TB, db *sql.DB, numRow int) Identifier {
+	tableName := RandomIdentifier("scanSlice_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer)", tableName)); err != nil {
+		tb.Fatal(err)
+	}
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values (?)", tableName))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer stmt.Close()
+	args := make([]any, numRow)
+	for i := 0; i < numRow; i++ {
+		args[i] = i
+	}
+	if _, err := stmt.Exec(args...); err != nil { // bulk insert
+		tb.Fatal(err)
+	}
+	return tableName
+}
+
+func TestScanSlice(t *testing.T) {
+	t.Parallel()
+
+	const numRow = 1000
+
+	ctx := context.Background()
+	db := MT.DB()
+	tableName := testScanSliceTable(t, db, numRow)
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var ids []int
+	if err := ScanSlice(ctx, conn, fmt.Sprintf("select i from %s order by i", tableName), &ids); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != numRow {
+		t.Fatalf("number of rows %d - expected %d", len(ids), numRow)
+	}
+	for i, id := range ids {
+		if id != i {
+			t.Fatalf("id %d - expected %d", id, i)
+		}
+	}
+
+	// query with arguments
+	var texts []string
+	if err := ScanSlice(ctx, conn, fmt.Sprintf("select to_nvarchar(i) from %s where i < ? order by i", tableName), &texts, 10); err != nil {
+		t.Fatal(err)
+	}
+	if len(texts) != 10 || texts[9] != "9" {
+		t.Fatalf("values %v - expected 10 values", texts)
+	}
+
+	// multi column result must fail
+	if err := ScanSlice(ctx, conn, fmt.Sprintf("select i, i from %s", tableName), &ids); err == nil {
+		t.Fatal("expected error for multi column result")
+	}
+	// the connection is still usable
+	var cnt int
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("select count(*) from %s", tableName)).Scan(&cnt); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkScanSlice(b *testing.B) {
+	const numRow = 10000
+
+	ctx := context.Background()
+	db := MT.DB()
+	tableName := testScanSliceTable(b, db, numRow)
+	query := fmt.Sprintf("select i from %s", tableName)
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer conn.Close()
+
+	b.Run("ScanSlice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ids := make([]int, 0, numRow)
+			if err := ScanSlice(ctx, conn, query, &ids); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Scan loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rows, err := conn.QueryContext(ctx, query)
+			if err != nil {
+				b.Fatal(err)
+			}
+			ids := make([]int, 0, numRow)
+			for rows.Next() {
+				var id int
+				if err := rows.Scan(&id); err != nil {
+					b.Fatal(err)
+				}
+				ids = append(ids, id)
+			}
+			if err := rows.Err(); err != nil {
+				b.Fatal(err)
+			}
+			rows.Close()
+		}
+	})
+}
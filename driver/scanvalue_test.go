@@ -0,0 +1,72 @@
+package driver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func testScanValue[T any](t *testing.T, v driver.Value, expected T, equal func(a, b T) bool) {
+	t.Helper()
+	var dest T
+	if err := scanValue(v, &dest); err != nil {
+		t.Fatalf("value %v type %T: %s", v, v, err)
+	}
+	if !equal(dest, expected) {
+		t.Fatalf("value %v - expected %v", dest, expected)
+	}
+}
+
+func testScanValueError[T any](t *testing.T, v driver.Value) {
+	t.Helper()
+	var dest T
+	if err := scanValue(v, &dest); err == nil {
+		t.Fatalf("value %v type %T: conversion to %T error expected", v, v, dest)
+	}
+}
+
+func equalValue[T comparable](a, b T) bool { return a == b }
+
+func TestScanValue(t *testing.T) {
+	now := time.Now()
+
+	// assignable values
+	testScanValue(t, int64(42), int64(42), equalValue)
+	testScanValue(t, "go-hdb", "go-hdb", equalValue)
+	testScanValue(t, now, now, equalValue)
+	testScanValue[any](t, int64(42), int64(42), func(a, b any) bool { return a == b })
+
+	// converted values
+	testScanValue(t, int64(42), 42, equalValue)
+	testScanValue(t, int64(42), uint8(42), equalValue)
+	testScanValue(t, int64(42), float64(42), equalValue)
+	testScanValue(t, float64(1.5), float32(1.5), equalValue)
+	testScanValue(t, []byte("go-hdb"), "go-hdb", equalValue)
+	testScanValue(t, "go-hdb", []byte("go-hdb"), func(a, b []byte) bool { return string(a) == string(b) })
+	testScanValue(t, int64(42), new(int), func(a, b *int) bool { return a != nil && *a == 42 })
+
+	// null values
+	testScanValue(t, nil, (*int)(nil), equalValue)
+	testScanValue(t, nil, sql.NullInt64{}, equalValue)
+	testScanValue(t, int64(42), sql.NullInt64{Int64: 42, Valid: true}, equalValue)
+
+	// byte values are copied (value buffer)
+	b := []byte("go-hdb")
+	var dest []byte
+	if err := scanValue(b, &dest); err != nil {
+		t.Fatal(err)
+	}
+	b[0] = 'x'
+	if string(dest) != "go-hdb" {
+		t.Fatalf("value %s - expected %s", dest, "go-hdb")
+	}
+
+	// conversion errors
+	testScanValueError[int](t, nil)
+	testScanValueError[int8](t, int64(128))
+	testScanValueError[uint](t, int64(-1))
+	testScanValueError[float32](t, float64(1e300))
+	testScanValueError[int](t, "42")
+	testScanValueError[bool](t, int64(1))
+}
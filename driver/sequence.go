@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// quoteSQLIdentifier returns s as delimited SQL identifier, doubling embedded double quotes.
+func quoteSQLIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// NextVal returns the next value of the database sequence with name sequence in schema schema.
+// If schema is empty the sequence is resolved within the current schema.
+// Schema and sequence name are always quoted as delimited identifiers, so that they cannot be used for sql injection.
+// As a consequence the names are case sensitive and need to be provided as stored in the database catalog
+// (e.g. upper case for sequences created with unquoted names).
+func NextVal(ctx context.Context, q Queryer, schema, sequence string) (int64, error) {
+	values, err := NextValN(ctx, q, schema, sequence, 1)
+	if err != nil {
+		return 0, err
+	}
+	return values[0], nil
+}
+
+// NextValN returns a block of n next values of the database sequence with name sequence in schema schema within one database roundtrip.
+// Please note that the values are not necessarily consecutive, as concurrent sessions might increment the sequence
+// in parallel. For the quoting of schema and sequence name please see NextVal.
+func NextValN(ctx context.Context, q Queryer, schema, sequence string, n int) ([]int64, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid number of sequence values %d", n)
+	}
+	name := quoteSQLIdentifier(sequence)
+	if schema != "" {
+		name = quoteSQLIdentifier(schema) + "." + name
+	}
+	rows, err := q.QueryContext(ctx, fmt.Sprintf("select %s.nextval from series_generate_integer(1, 0, ?)", name), n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make([]int64, 0, n)
+	for rows.Next() {
+		var v int64
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(values) != n {
+		return nil, fmt.Errorf("number of sequence values %d - expected %d", len(values), n)
+	}
+	return values, nil
+}
@@ -0,0 +1,68 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestNextVal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	sequence := RandomIdentifier("seq_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create sequence %s start with 1 increment by 1", sequence)); err != nil {
+		t.Fatal(err)
+	}
+	defer db.ExecContext(ctx, fmt.Sprintf("drop sequence %s", sequence)) //nolint:errcheck
+
+	var schema string
+	if err := db.QueryRowContext(ctx, "select current_schema from dummy").Scan(&schema); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := NextVal(ctx, db, "", string(sequence))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Fatalf("value %d - expected %d", v, 1)
+	}
+
+	const n = 5
+	values, err := NextValN(ctx, db, "", string(sequence), n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != n {
+		t.Fatalf("number of values %d - expected %d", len(values), n)
+	}
+	for i, v := range values {
+		if v != int64(i+2) {
+			t.Fatalf("value %d: %d - expected %d", i, v, i+2)
+		}
+	}
+
+	// schema qualified
+	if v, err = NextVal(ctx, db, schema, string(sequence)); err != nil {
+		t.Fatal(err)
+	}
+	if v != n+2 {
+		t.Fatalf("value %d - expected %d", v, n+2)
+	}
+
+	// schema and sequence name are quoted
+	if _, err := NextVal(ctx, db, "", `dummy".nextval from dummy; --`); err == nil {
+		t.Fatal("error expected")
+	}
+	if _, err := NextVal(ctx, db, `x"."`+string(sequence), string(sequence)); err == nil {
+		t.Fatal("error expected")
+	}
+	if _, err := NextValN(ctx, db, "", string(sequence), 0); err == nil {
+		t.Fatal("error expected")
+	}
+}
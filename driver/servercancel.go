@@ -0,0 +1,77 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	queryConnectionID   = "select current_connection from dummy"
+	cancelWorkInSession = "alter system cancel work in session '%d'"
+)
+
+// queryConnectionID returns the connection id of the database server session.
+func (c *conn) queryConnectionID(ctx context.Context) (int64, error) {
+	rows, err := c.QueryContext(ctx, queryConnectionID, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		return 0, err
+	}
+	switch id := dest[0].(type) {
+	case int64:
+		return id, nil
+	case int32:
+		return int64(id), nil
+	default:
+		return 0, fmt.Errorf("invalid connection id type %T", dest[0])
+	}
+}
+
+/*
+cancelled marks the connection as cancelled and cancels the statement executed by the database server
+in case server cancellation is enabled (see Connector.SetServerCancel).
+If the db call did complete in the meantime (done is closed) there is nothing to cancel. Otherwise the
+cancellation might still race with the completion of the statement, which is harmless, as the connection
+is marked bad and not used for any further statement.
+*/
+func (c *conn) cancelled(done <-chan struct{}) {
+	c.setLastError(context.Background(), errCancelled)
+	if c.cancelConnect == nil {
+		return
+	}
+	select {
+	case <-done:
+		return
+	default:
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		if err := c.cancelServer(); err != nil {
+			c.logger.LogAttrs(context.Background(), slog.LevelError, "DB server cancel error", slog.String("error", err.Error()), slog.Int64("connection id", c.connectionID))
+		}
+	}()
+}
+
+// cancelServer cancels the statement executed by the database server session of c via a separate connection.
+func (c *conn) cancelServer() error {
+	ctx := context.Background()
+	if c.attrs._timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.attrs._timeout)
+		defer cancel()
+	}
+	driverConn, err := c.cancelConnect(ctx)
+	if err != nil {
+		return err
+	}
+	defer driverConn.Close()
+	_, err = driverConn.(*conn).ExecContext(ctx, fmt.Sprintf(cancelWorkInSession, c.connectionID), nil)
+	return err
+}
@@ -0,0 +1,86 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// testDBError simulates database errors.
+type testDBError struct {
+	code       int
+	level      int
+	text       string
+	connection bool // connection error
+}
+
+func (e *testDBError) Error() string          { return fmt.Sprintf("SQL %d - %s", e.code, e.text) }
+func (e *testDBError) StmtNo() int            { return 0 }
+func (e *testDBError) Code() int              { return e.code }
+func (e *testDBError) Position() int          { return 0 }
+func (e *testDBError) Level() int             { return e.level }
+func (e *testDBError) Text() string           { return e.text }
+func (e *testDBError) Details() *ErrorDetails { return &ErrorDetails{} }
+func (e *testDBError) IsWarning() bool        { return e.level == HdbWarning }
+func (e *testDBError) IsError() bool          { return e.level == HdbError }
+func (e *testDBError) IsFatal() bool          { return e.level == HdbFatalError }
+
+func (e *testDBError) NumError() int               { return 1 }
+func (e *testDBError) Unwrap() []error             { return nil }
+func (e *testDBError) SetIdx(idx int)              {}
+func (e *testDBError) IsRetriable() bool           { return e.connection }
+func (e *testDBError) IsTransactionRollback() bool { return false }
+func (e *testDBError) IsConnectionError() bool     { return e.connection }
+
+func TestSessionInvalidated(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		invalidated bool
+		bad         bool
+	}{
+		{"nil", nil, false, false},
+		{"noDBError", errors.New("test error"), false, false},
+		{"badConn", driver.ErrBadConn, false, true},
+		{"error", &testDBError{code: 259, level: HdbError, text: "invalid table name"}, false, false},
+		{"notConnected", &testDBError{code: 1, level: HdbError, text: "Session not connected", connection: true}, true, true},
+		{"takeover", &testDBError{code: 1, level: HdbFatalError, text: "session terminated by takeover", connection: true}, true, true},
+		{"wrapped", fmt.Errorf("exec: %w", &testDBError{code: 1, level: HdbFatalError, text: "session terminated", connection: true}), true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if invalidated := IsSessionInvalidated(test.err); invalidated != test.invalidated {
+				t.Fatalf("session invalidated %t - expected %t", invalidated, test.invalidated)
+			}
+			c := &conn{lastError: test.err}
+			if valid := c.IsValid(); valid == test.bad {
+				t.Fatalf("connection valid %t - expected %t", valid, !test.bad)
+			}
+		})
+	}
+}
+
+func TestStatementInvalidated(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		invalidated bool
+	}{
+		{"nil", nil, false},
+		{"noDBError", errors.New("statement invalidated"), false},
+		{"error", &testDBError{code: 259, level: HdbError, text: "invalid table name"}, false},
+		{"invalidated", &testDBError{code: 1, level: HdbError, text: "Statement has been invalidated"}, true},
+		{"invalidStmtID", &testDBError{code: 1, level: HdbError, text: "invalid statement id: 1234"}, true},
+		{"sessionInvalidated", &testDBError{code: 1, level: HdbFatalError, text: "session invalidated"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if invalidated := IsStatementInvalidated(test.err); invalidated != test.invalidated {
+				t.Fatalf("statement invalidated %t - expected %t", invalidated, test.invalidated)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+//go:build !unit
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSpatialScalar(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	// spatial scalar functions (st_distance, st_area, ...) are returning double values.
+	tests := []struct {
+		name     string
+		query    string
+		expected float64
+	}{
+		{"distance", "select new st_point(0, 0).st_distance(new st_point(3, 4)) from dummy", 5},
+		{"area", "select new st_polygon('POLYGON ((0 0, 2 0, 2 2, 0 2, 0 0))').st_area() from dummy", 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var f float64
+			if err := db.QueryRowContext(ctx, test.query).Scan(&f); err != nil {
+				t.Fatal(err)
+			}
+			if f != test.expected {
+				t.Fatalf("got %f - expected %f", f, test.expected)
+			}
+		})
+	}
+
+	// decimal results need to be scanned into a Decimal.
+	t.Run("decimal", func(t *testing.T) {
+		var d Decimal
+		if err := db.QueryRowContext(ctx, "select to_decimal(new st_point(0, 0).st_distance(new st_point(3, 4)), 10, 2) from dummy").Scan(&d); err != nil {
+			t.Fatal(err)
+		}
+		if f, exact := d.Float64(); f != 5 || !exact {
+			t.Fatalf("got %f (exact %t) - expected %f", f, exact, 5.0)
+		}
+	})
+}
+
+func TestGeometryColumn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	tableName := RandomIdentifier("geometry_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, g st_geometry)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	point := Geometry{0x01, 0x01, 0x00, 0x00, 0x00, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0x40} // POINT (1 2)
+
+	// large geometry exceeding the lob size threshold
+	var wkt strings.Builder
+	wkt.WriteString("LINESTRING (")
+	for i := 0; i < 10000; i++ {
+		if i != 0 {
+			wkt.WriteString(",")
+		}
+		fmt.Fprintf(&wkt, "%d %d", i, i)
+	}
+	wkt.WriteString(")")
+	var large Geometry
+	if err := db.QueryRowContext(ctx, "select st_geomfromtext(?) from dummy", wkt.String()).Scan(&large); err != nil {
+		t.Fatal(err)
+	}
+
+	values := []Geometry{point, nil, large}
+	for i, g := range values {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?, ?)", tableName), i, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("select g from %s order by i", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	i := 0
+	for rows.Next() {
+		var g Geometry
+		if err := rows.Scan(&g); err != nil {
+			t.Fatal(err)
+		}
+		if (g == nil) != (values[i] == nil) || !bytes.Equal(g, values[i]) {
+			t.Fatalf("row %d: got %x - expected %x", i, g, values[i])
+		}
+		i++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(values) {
+		t.Fatalf("rows %d - expected %d", i, len(values))
+	}
+}
+
+func TestPointColumn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := MT.DB()
+
+	tableName := RandomIdentifier("point_")
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, p st_point(4326))", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	values := []NullPoint{{Point: Point{X: 8.6821, Y: 50.1109, SRID: 4326}, Valid: true}, {}}
+	for i, p := range values {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("insert into %s values (?, ?)", tableName), i, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("select p, p.st_asewkb() from %s order by i", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	i := 0
+	for rows.Next() {
+		var wkb, ewkb NullPoint
+		if err := rows.Scan(&wkb, &ewkb); err != nil {
+			t.Fatal(err)
+		}
+		if ewkb != values[i] {
+			t.Fatalf("row %d: got %v - expected %v", i, ewkb, values[i])
+		}
+		if wkb.Valid != values[i].Valid || wkb.Point.X != values[i].Point.X || wkb.Point.Y != values[i].Point.Y || wkb.Point.SRID != 0 {
+			t.Fatalf("row %d: got %v - expected %v without srid", i, wkb, values[i])
+		}
+		i++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(values) {
+		t.Fatalf("rows %d - expected %d", i, len(values))
+	}
+}
@@ -26,23 +26,13 @@ type stmt struct {
 	pr    *prepareResult
 	// rows: stored procedures with table output parameters
 	rows *sql.Rows
-}
-
-type totalRowsAffected int64
-
-func (t *totalRowsAffected) add(r driver.Result) {
-	if r == nil {
-		return
-	}
-	rows, err := r.RowsAffected()
-	if err != nil {
-		return
-	}
-	*t += totalRowsAffected(rows)
+	// assignOut: assigns the output parameters of the last call (see assignOutArgs)
+	assignOut func() error
 }
 
 func newStmt(conn *conn, query string, pr *prepareResult) *stmt {
 	conn.metrics.msgCh <- gaugeMsg{idx: gaugeStmt, v: 1} // increment number of statements.
+	conn.stats.numOpenStmt.Add(1)
 	return &stmt{conn: conn, query: query, pr: pr}
 }
 
@@ -59,6 +49,7 @@ func (s *stmt) Close() error {
 	c := s.conn
 
 	c.metrics.msgCh <- gaugeMsg{idx: gaugeStmt, v: -1} // decrement number of statements.
+	c.stats.numOpenStmt.Add(-1)
 
 	if s.rows != nil {
 		s.rows.Close()
@@ -66,6 +57,10 @@ func (s *stmt) Close() error {
 	if c.isBad() {
 		return driver.ErrBadConn
 	}
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
 	return c.dropStatementID(context.Background(), s.pr.stmtID)
 }
 
@@ -76,32 +71,31 @@ func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
 }
 
 func (s *stmt) QueryContext(ctx context.Context, nvargs []driver.NamedValue) (driver.Rows, error) {
+	c := s.conn
 	if s.pr.isProcedureCall() {
-		return nil, fmt.Errorf("invalid procedure call %s - please use Exec instead", s.query)
+		if c.sqlTrace {
+			defer c.logSQLTrace(ctx, time.Now(), s.query, nvargs)
+		}
+		return c.runQuery(ctx, false, func() (driver.Rows, error) { return s.queryCall(ctx, nvargs) })
+	}
+	if err := bindNamedArgs(s.query, nvargs); err != nil {
+		return nil, err
+	}
+	if numNVArg, numField := len(nvargs), s.pr.numField(); numNVArg != numField {
+		return nil, numArgError(s.query, numNVArg, numField)
 	}
-	c := s.conn
 	if c.sqlTrace {
 		defer c.logSQLTrace(ctx, time.Now(), s.query, nvargs)
 	}
 
-	done := make(chan struct{})
-	var rows driver.Rows
-	var err error
-	c.wg.Add(1)
-	go func() {
-		defer c.wg.Done()
-		rows, err = c.query(ctx, s.pr, nvargs, !s.conn.inTx)
-		close(done)
-	}()
-
-	select {
-	case <-ctx.Done():
-		c.lastError = errCancelled
-		return nil, ctx.Err()
-	case <-done:
-		c.lastError = err
+	return c.runQuery(ctx, s.pr.isSelect(), func() (driver.Rows, error) {
+		retryArgs := slices.Clone(nvargs) // arguments get converted in place
+		rows, err := c.query(ctx, s.pr, nvargs, !s.conn.inTx)
+		if s.reprepareOnInvalidation(ctx, err, nvargs) {
+			rows, err = c.query(ctx, s.pr, retryArgs, !s.conn.inTx)
+		}
 		return rows, err
-	}
+	})
 }
 
 func (s *stmt) ExecContext(ctx context.Context, nvargs []driver.NamedValue) (driver.Result, error) {
@@ -113,45 +107,202 @@ func (s *stmt) ExecContext(ctx context.Context, nvargs []driver.NamedValue) (dri
 		defer c.logSQLTrace(ctx, time.Now(), s.query, nvargs)
 	}
 
+	if !s.pr.isProcedureCall() {
+		if err := bindNamedArgs(s.query, nvargs); err != nil {
+			return nil, err
+		}
+	}
+	bulk := s.isBulk(nvargs)
+	// bulk execs and lob streaming are bound to ctx and do stop promptly.
+	waitOnCancel := bulk || slices.ContainsFunc(s.pr.parameterFields, (*p.ParameterField).IsLob)
+
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+
+	numMessage := c.pw.NumMessage()
+
 	done := make(chan struct{})
 	var result driver.Result
 	var err error
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
-		if s.pr.isProcedureCall() {
-			result, s.rows, err = s.execCall(ctx, s.pr, nvargs)
-		} else {
-			result, err = s.execDefault(ctx, nvargs)
-		}
+		defer c.unlock()
+		result, err = s.execContext(ctx, nvargs, bulk)
 		close(done)
 	}()
 
 	select {
 	case <-ctx.Done():
-		c.lastError = errCancelled
+		c.cancelled(done) // cancels the statement in progress on the database server if enabled (see ServerCancel)
+		if waitOnCancel {
+			// wait for the number of rows affected or bytes written.
+			<-done
+			return result, err
+		}
 		return nil, ctx.Err()
 	case <-done:
-		c.lastError = err
-		return result, err
+		lastError := err
+		if waitOnCancel && ctx.Err() != nil {
+			lastError = errCancelled
+		}
+		c.setLastError(ctx, lastError)
+		if err != nil {
+			return result, c.retryError(err, c.pw.NumMessage() != numMessage, false)
+		}
+		if err := s.assignOutArgs(); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+}
+
+/*
+assignOutArgs assigns the output parameters of the last call to their sql.Out destinations.
+The assignment is done after the call released the connection lock, as scanning the output parameters
+might need further database round-trips (e.g. reading lobs).
+*/
+func (s *stmt) assignOutArgs() error {
+	assignOut := s.assignOut
+	if assignOut == nil {
+		return nil
+	}
+	s.assignOut = nil
+	return assignOut()
+}
+
+func (s *stmt) execContext(ctx context.Context, nvargs []driver.NamedValue, bulk bool) (driver.Result, error) {
+	if s.conn.attrs._tracer != nil {
+		op := SpanExec
+		if s.pr.isProcedureCall() {
+			op = SpanCall
+		}
+		return traced(ctx, s.conn, op, s.query, s.pr, func(ctx context.Context) (driver.Result, error) { return s._execContext(ctx, nvargs, bulk) })
+	}
+	return s._execContext(ctx, nvargs, bulk)
+}
+
+func (s *stmt) _execContext(ctx context.Context, nvargs []driver.NamedValue, bulk bool) (result driver.Result, err error) {
+	switch {
+	case s.pr.isProcedureCall():
+		result, s.assignOut, err = s.execCall(ctx, s.pr, nvargs)
+	case bulk:
+		result, err = s.execDefault(ctx, nvargs)
+	default:
+		retryArgs := slices.Clone(nvargs) // arguments get converted in place
+		result, err = s.execDefault(ctx, nvargs)
+		if s.reprepareOnInvalidation(ctx, err, nvargs) {
+			result, err = s.execDefault(ctx, retryArgs)
+		}
+	}
+	return result, err
+}
+
+/*
+reprepareOnInvalidation re-prepares the statement in case err reports an invalidated statement and returns true
+if the statement should be executed again. To avoid sending parameter values twice, which might have been
+consumed partially (LOB readers), the statement is not executed again in case of LOB arguments.
+As the statement is re-prepared at most once per execution, endless loops are avoided.
+*/
+func (s *stmt) reprepareOnInvalidation(ctx context.Context, err error, nvargs []driver.NamedValue) bool {
+	if !IsStatementInvalidated(err) {
+		return false
+	}
+	for _, nv := range nvargs {
+		if _, ok := nv.Value.(*p.LobInDescr); ok {
+			return false
+		}
+	}
+	c := s.conn
+	pr, err := c.prepare(ctx, s.query)
+	if err != nil {
+		return false
 	}
+	c.dropStatementID(ctx, s.pr.stmtID) //nolint:errcheck // statement is invalid anyway
+	s.pr = pr
+	return true
 }
 
-func (s *stmt) execCall(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue) (driver.Result, *sql.Rows, error) {
+// execCall executes a procedure call and returns the function assigning the output parameters (see assignOutArgs).
+func (s *stmt) execCall(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue) (driver.Result, func() error, error) {
 	c := s.conn
+
+	nvargs, err := structCallArgs(pr.parameterFields, nvargs)
+	if err != nil {
+		return nil, nil, err
+	}
+	numArrayRow, isArray, err := convertArrayCallArgs(pr.parameterFields, nvargs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isArray {
+		return s.execArrayCall(ctx, pr, nvargs, numArrayRow)
+	}
+
 	defer c.addSQLTimeValue(time.Now(), sqlTimeCall)
 
-	callArgs, err := convertCallArgs(pr.parameterFields, nvargs, c.attrs._cesu8Encoder(), c.attrs._lobChunkSize)
+	callArgs, cr, numRow, err := s.call(ctx, pr, nvargs)
 	if err != nil {
 		return nil, nil, err
 	}
+
+	// close result sets (e.g. of inline selects) not bound to an output argument to keep the connection clean.
+	if numOutArg := len(callArgs.outArgs); len(cr.outputFields) > numOutArg {
+		for _, v := range cr.fieldValues[numOutArg:] {
+			if qr, ok := v.(*queryResult); ok {
+				if err := qr.close(); err != nil { // connection is locked by the call
+					return nil, nil, err
+				}
+			}
+		}
+		cr.outputFields, cr.fieldValues = cr.outputFields[:numOutArg], cr.fieldValues[:numOutArg]
+	}
+
+	// no output fields -> done
+	if len(cr.outputFields) == 0 {
+		return driver.RowsAffected(numRow), nil, nil
+	}
+
+	scanArgs := []any{}
+	for i := range cr.outputFields {
+		scanArgs = append(scanArgs, callArgs.outArgs[i].Value.(sql.Out).Dest)
+	}
+
+	// no table output parameters -> QueryRow
+	if len(callArgs.outFields) == len(callArgs.outArgs) {
+		return driver.RowsAffected(numRow), func() error { return stdConnTracker.callDB().QueryRow("", cr).Scan(scanArgs...) }, nil
+	}
+
+	// table output parameters -> Query (needs to kept open)
+	return driver.RowsAffected(numRow), func() error {
+		var err error
+		if s.rows, err = stdConnTracker.callDB().Query("", cr); err != nil {
+			return err
+		}
+		if !s.rows.Next() {
+			return s.rows.Err()
+		}
+		return s.rows.Scan(scanArgs...)
+	}, nil
+}
+
+// call executes the procedure call and returns the converted arguments, the call result and the number of rows affected.
+func (s *stmt) call(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue) (*callArgs, *callResult, int64, error) {
+	c := s.conn
+
+	callArgs, err := convertCallArgs(pr.parameterFields, nvargs, c.attrs._cesu8Encoder(), lobChunkSize(ctx, c.attrs._lobChunkSize), c.attrs.convertOptions())
+	if err != nil {
+		return nil, nil, 0, err
+	}
 	inputParameters, err := p.NewInputParameters(callArgs.inFields, callArgs.inArgs)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 	if err := c.pw.Write(ctx, c.sessionID, p.MtExecute, false, p.StatementID(pr.stmtID), inputParameters); err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
+	c.stats.lobBytesWritten.Add(uint64(inputParameters.LobBytes()))
 
 	/*
 		call without lob input parameters:
@@ -162,7 +313,7 @@ func (s *stmt) execCall(ctx context.Context, pr *prepareResult, nvargs []driver.
 
 	cr, ids, numRow, err := c.execCall(ctx, callArgs.outFields)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 
 	if len(ids) != 0 {
@@ -171,41 +322,102 @@ func (s *stmt) execCall(ctx context.Context, pr *prepareResult, nvargs []driver.
 			- chunkReaders
 			- cr (callResult output parameters are set after all lob input parameters are written)
 		*/
-		if err := c.encodeLobs(cr, ids, callArgs.inFields, callArgs.inArgs); err != nil {
-			return nil, nil, err
+		if err := c.encodeLobs(ctx, cr, ids, callArgs.inFields, callArgs.inArgs); err != nil {
+			return nil, nil, 0, err
 		}
 	}
+	return callArgs, cr, numRow, nil
+}
 
-	// no output fields -> done
-	if len(cr.outputFields) == 0 {
-		return driver.RowsAffected(numRow), nil, nil
+/*
+queryCall executes a procedure call returning all result sets of the procedure (table output parameters and
+result sets of e.g. inline selects) as rows in the order provided by the database server (procedure order).
+The scalar output parameters are assigned to their sql.Out destinations after the last result set is consumed
+or the rows are closed.
+*/
+func (s *stmt) queryCall(ctx context.Context, nvargs []driver.NamedValue) (driver.Rows, error) {
+	if s.conn.attrs._tracer != nil {
+		return traced(ctx, s.conn, SpanCall, s.query, s.pr, func(ctx context.Context) (driver.Rows, error) { return s._queryCall(ctx, nvargs) })
 	}
+	return s._queryCall(ctx, nvargs)
+}
 
-	scanArgs := []any{}
-	for i := range cr.outputFields {
-		scanArgs = append(scanArgs, callArgs.outArgs[i].Value.(sql.Out).Dest)
+func (s *stmt) _queryCall(ctx context.Context, nvargs []driver.NamedValue) (driver.Rows, error) {
+	c := s.conn
+	pr := s.pr
+
+	nvargs, err := structCallArgs(pr.parameterFields, nvargs)
+	if err != nil {
+		return nil, err
+	}
+	if len(nvargs) > len(pr.parameterFields) {
+		return nil, fmt.Errorf("invalid number of arguments %d - table output parameters are returned as result sets by query", len(nvargs))
+	}
+	if _, isArray, err := convertArrayCallArgs(pr.parameterFields, nvargs); err != nil || isArray {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New("array bound arguments are not supported by query - please use Exec instead")
 	}
 
-	// no table output parameters -> QueryRow
-	if len(callArgs.outFields) == len(callArgs.outArgs) {
-		if err := stdConnTracker.callDB().QueryRow("", cr).Scan(scanArgs...); err != nil {
-			return nil, nil, err
+	defer c.addSQLTimeValue(time.Now(), sqlTimeCall)
+
+	callArgs, cr, _, err := s.call(ctx, pr, nvargs)
+	if err != nil {
+		return nil, err
+	}
+
+	numOutArg := len(callArgs.outArgs)
+	rs := &callResultSets{}
+	for _, v := range cr.fieldValues[numOutArg:] {
+		if qr, ok := v.(*queryResult); ok {
+			rs.results = append(rs.results, qr)
 		}
-		return driver.RowsAffected(numRow), nil, nil
 	}
+	cr.outputFields, cr.fieldValues = cr.outputFields[:numOutArg], cr.fieldValues[:numOutArg]
 
-	// table output parameters -> Query (needs to kept open)
-	rows, err := stdConnTracker.callDB().Query("", cr)
+	if numOutArg != 0 {
+		scanArgs := make([]any, numOutArg)
+		for i, nv := range callArgs.outArgs {
+			scanArgs[i] = nv.Value.(sql.Out).Dest
+		}
+		rs.assignOut = func() error { return stdConnTracker.callDB().QueryRow("", cr).Scan(scanArgs...) }
+	}
+	return rs, nil
+}
+
+// execArrayCall executes a procedure call with array bound input arguments for all rows within one database round-trip.
+func (s *stmt) execArrayCall(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue, numRow int) (driver.Result, func() error, error) {
+	c := s.conn
+
+	if numRow == 0 {
+		return driver.RowsAffected(0), nil, nil
+	}
+	defer c.addSQLTimeValue(time.Now(), sqlTimeCall)
+
+	var inFields []*p.ParameterField
+	inArgs := make([]driver.NamedValue, 0, numRow*len(nvargs))
+	for i := 0; i < numRow; i++ {
+		callArgs, err := convertCallArgs(pr.parameterFields, arrayCallArgsRow(nvargs, i), c.attrs._cesu8Encoder(), lobChunkSize(ctx, c.attrs._lobChunkSize), c.attrs.convertOptions())
+		if err != nil {
+			return nil, nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		inFields = callArgs.inFields
+		inArgs = append(inArgs, callArgs.inArgs...)
+	}
+	inputParameters, err := p.NewInputParameters(inFields, inArgs)
 	if err != nil {
-		return nil, rows, err
+		return nil, nil, err
 	}
-	if !rows.Next() {
-		return nil, rows, rows.Err()
+	if err := c.pw.Write(ctx, c.sessionID, p.MtExecute, false, p.StatementID(pr.stmtID), inputParameters); err != nil {
+		return nil, nil, err
 	}
-	if err := rows.Scan(scanArgs...); err != nil {
-		return nil, rows, err
+	c.stats.lobBytesWritten.Add(uint64(inputParameters.LobBytes()))
+	_, _, rowsAffected, err := c.execCall(ctx, nil)
+	if err != nil {
+		return nil, nil, err
 	}
-	return driver.RowsAffected(numRow), rows, nil
+	return driver.RowsAffected(rowsAffected), nil, nil
 }
 
 func (s *stmt) execDefault(ctx context.Context, nvargs []driver.NamedValue) (driver.Result, error) {
@@ -215,19 +427,25 @@ func (s *stmt) execDefault(ctx context.Context, nvargs []driver.NamedValue) (dri
 
 	if numNVArg == 0 {
 		if numField != 0 {
-			return nil, fmt.Errorf("invalid number of arguments %d - expected %d", numNVArg, numField)
+			return nil, numArgError(s.query, numNVArg, numField)
 		}
 		return c.exec(ctx, s.pr, nvargs, !c.inTx, 0)
 	}
 	if numNVArg == 1 {
-		if _, ok := nvargs[0].Value.(func(args []any) error); ok {
+		switch columns := nvargs[0].Value.(type) {
+		case func(args []any) error:
 			return s.execFct(ctx, nvargs)
+		case columnBatch:
+			return s.execColumns(ctx, columns)
 		}
 	}
 	if numNVArg == numField {
 		return s.exec(ctx, s.pr, nvargs, !c.inTx, 0)
 	}
 	if numNVArg%numField != 0 {
+		if len(namedPlaceholders(s.query)) != 0 {
+			return nil, numArgError(s.query, numNVArg, numField)
+		}
 		return nil, fmt.Errorf("invalid number of arguments %d - multiple of %d expected", numNVArg, numField)
 	}
 	return s.execMany(ctx, nvargs)
@@ -237,6 +455,50 @@ func (s *stmt) execDefault(ctx context.Context, nvargs []driver.NamedValue) (dri
 // the end of rows.
 var ErrEndOfRows = errors.New("end of rows")
 
+/*
+BulkCancelError is returned by a bulk exec in case the context got cancelled during execution.
+The buffering of further rows is stopped and a package being written to the database server is
+aborted by closing the network operation. RowsAffected reports the number of rows of all packages
+confirmed by the database server before the cancellation. In auto-commit mode these rows are committed,
+within a transaction they are part of the (still open) transaction.
+Rows of an aborted package are not included, as the outcome of the package is unknown to the client.
+As the connection is reported as bad after a cancellation, a transaction cannot be committed anymore.
+*/
+type BulkCancelError struct {
+	RowsAffected int64
+	err          error
+}
+
+func (e *BulkCancelError) Error() string {
+	return fmt.Sprintf("bulk exec cancelled after %d rows affected: %s", e.RowsAffected, e.err)
+}
+
+// Unwrap returns the context error.
+func (e *BulkCancelError) Unwrap() error { return e.err }
+
+// bulkError returns a BulkCancelError in case ctx is cancelled, err otherwise.
+func bulkError(ctx context.Context, result *BatchResult, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return &BulkCancelError{RowsAffected: result.total(), err: ctxErr}
+	}
+	return err
+}
+
+// isBulk returns true if nvargs would be executed in bulk mode (execFct or execMany).
+func (s *stmt) isBulk(nvargs []driver.NamedValue) bool {
+	if s.pr.isProcedureCall() {
+		return false
+	}
+	numNVArg, numField := len(nvargs), s.pr.numField()
+	if numNVArg == 1 {
+		switch nvargs[0].Value.(type) {
+		case func(args []any) error, columnBatch:
+			return true
+		}
+	}
+	return numField != 0 && numNVArg > numField && numNVArg%numField == 0
+}
+
 /*
 Non 'atomic' (transactional) operation due to the split in packages (bulkSize),
 execMany data might only be written partially to the database in case of hdb stmt errors.
@@ -244,7 +506,7 @@ execMany data might only be written partially to the database in case of hdb stm
 func (s *stmt) execFct(ctx context.Context, nvargs []driver.NamedValue) (driver.Result, error) {
 	c := s.conn
 
-	totalRowsAffected := totalRowsAffected(0)
+	result := &BatchResult{}
 	args := make([]driver.NamedValue, 0, s.pr.numField())
 	scanArgs := make([]any, s.pr.numField())
 
@@ -253,6 +515,10 @@ func (s *stmt) execFct(ctx context.Context, nvargs []driver.NamedValue) (driver.
 		panic("should never happen")
 	}
 
+	// bind the bulk exec to ctx to abort a package being written in case of cancellation.
+	c.dbConn.startHandshake(ctx)
+	defer c.dbConn.endHandshake()
+
 	done := false
 	batch := 0
 	for !done {
@@ -264,7 +530,10 @@ func (s *stmt) execFct(ctx context.Context, nvargs []driver.NamedValue) (driver.
 				break
 			}
 			if err != nil {
-				return driver.RowsAffected(totalRowsAffected), err
+				return result, err
+			}
+			if err := ctx.Err(); err != nil { // stop buffering
+				return result, bulkError(ctx, result, err)
 			}
 
 			args = slices.Grow(args, len(scanArgs))
@@ -283,14 +552,14 @@ func (s *stmt) execFct(ctx context.Context, nvargs []driver.NamedValue) (driver.
 
 		if len(args) != 0 {
 			r, err := s.exec(ctx, s.pr, args, !c.inTx, batch*c.attrs._bulkSize)
-			totalRowsAffected.add(r)
+			result.add(r)
 			if err != nil {
-				return driver.RowsAffected(totalRowsAffected), err
+				return result, bulkError(ctx, result, err)
 			}
 		}
 		batch++
 	}
-	return driver.RowsAffected(totalRowsAffected), nil
+	return result, nil
 }
 
 /*
@@ -301,7 +570,7 @@ func (s *stmt) execMany(ctx context.Context, nvargs []driver.NamedValue) (driver
 	c := s.conn
 	bulkSize := c.attrs._bulkSize
 
-	totalRowsAffected := totalRowsAffected(0)
+	result := &BatchResult{}
 	numField := s.pr.numField()
 	numNVArg := len(nvargs)
 	numRec := numNVArg / numField
@@ -310,19 +579,90 @@ func (s *stmt) execMany(ctx context.Context, nvargs []driver.NamedValue) (driver
 		numBatch++
 	}
 
+	// bind the bulk exec to ctx to abort a package being written in case of cancellation.
+	c.dbConn.startHandshake(ctx)
+	defer c.dbConn.endHandshake()
+
 	for i := 0; i < numBatch; i++ {
+		if err := ctx.Err(); err != nil {
+			return result, bulkError(ctx, result, err)
+		}
 		from := i * numField * bulkSize
 		to := (i + 1) * numField * bulkSize
 		if to > numNVArg {
 			to = numNVArg
 		}
 		r, err := s.exec(ctx, s.pr, nvargs[from:to], !c.inTx, i*bulkSize)
-		totalRowsAffected.add(r)
+		result.add(r)
 		if err != nil {
-			return driver.RowsAffected(totalRowsAffected), err
+			return result, bulkError(ctx, result, err)
 		}
 	}
-	return driver.RowsAffected(totalRowsAffected), nil
+	return result, nil
+}
+
+/*
+execColumns executes a bulk statement with the rows provided column-wise (see BulkInserter).
+The values are converted once per column and executed in packages of bulkSize rows like in execMany.
+*/
+func (s *stmt) execColumns(ctx context.Context, columns columnBatch) (driver.Result, error) {
+	c := s.conn
+	defer c.addSQLTimeValue(time.Now(), sqlTimeExec)
+	bulkSize := c.attrs._bulkSize
+
+	fields := s.pr.parameterFields
+	numField := len(fields)
+	if len(columns) != numField {
+		return nil, fmt.Errorf("invalid number of columns %d - %d expected", len(columns), numField)
+	}
+
+	numRec := 0
+	var nvargs []driver.NamedValue
+	cesu8Encoder := c.attrs._cesu8Encoder()
+	for j, field := range fields {
+		if field.Out() {
+			return nil, fmt.Errorf("invalid parameter %s - output not allowed", field)
+		}
+		values, err := convertColumn(field, columns[j], cesu8Encoder, c.attrs.convertOptions())
+		if err != nil {
+			return nil, err
+		}
+		if j == 0 {
+			numRec = len(values)
+			nvargs = make([]driver.NamedValue, numRec*numField)
+		} else if len(values) != numRec {
+			return nil, fmt.Errorf("invalid number of values %d of column %d - %d expected", len(values), j+1, numRec)
+		}
+		for i, v := range values {
+			nvargs[(i*numField)+j] = driver.NamedValue{Ordinal: j + 1, Value: v}
+		}
+	}
+	if numRec == 0 {
+		return &BatchResult{}, nil
+	}
+
+	// bind the bulk exec to ctx to abort a package being written in case of cancellation.
+	c.dbConn.startHandshake(ctx)
+	defer c.dbConn.endHandshake()
+
+	result := &BatchResult{}
+	for i := 0; i*bulkSize < numRec; i++ {
+		if err := ctx.Err(); err != nil {
+			return result, bulkError(ctx, result, err)
+		}
+		from := i * numField * bulkSize
+		to := min((i+1)*numField*bulkSize, len(nvargs))
+		addLobDataRecs, err := fetchFirstLobChunks(numField, nvargs[from:to], lobChunkSize(ctx, c.attrs._lobChunkSize))
+		if err != nil {
+			return result, bulkError(ctx, result, err)
+		}
+		r, err := s.execPiecewise(ctx, s.pr, nvargs[from:to], addLobDataRecs, !c.inTx, i*bulkSize)
+		result.add(r)
+		if err != nil {
+			return result, bulkError(ctx, result, err)
+		}
+	}
+	return result, nil
 }
 
 /*
@@ -349,24 +689,30 @@ func (s *stmt) exec(ctx context.Context, pr *prepareResult, nvargs []driver.Name
 	c := s.conn
 	defer c.addSQLTimeValue(time.Now(), sqlTimeExec)
 
-	addLobDataRecs, err := convertExecArgs(pr.parameterFields, nvargs, c.attrs._cesu8Encoder(), c.attrs._lobChunkSize)
+	addLobDataRecs, err := convertExecArgs(pr.parameterFields, nvargs, c.attrs._cesu8Encoder(), lobChunkSize(ctx, c.attrs._lobChunkSize), c.attrs.convertOptions())
 	if err != nil {
 		return driver.ResultNoRows, err
 	}
+	return s.execPiecewise(ctx, pr, nvargs, addLobDataRecs, commit, ofs)
+}
+
+// execPiecewise executes the converted rows in nvargs split into packages at the rows in addLobDataRecs (see exec).
+func (s *stmt) execPiecewise(ctx context.Context, pr *prepareResult, nvargs []driver.NamedValue, addLobDataRecs []int, commit bool, ofs int) (driver.Result, error) {
+	c := s.conn
 
 	// piecewise LOB handling
 	numColumn := len(pr.parameterFields)
-	totalRowsAffected := totalRowsAffected(0)
+	result := &BatchResult{}
 	from := 0
 	for i := 0; i < len(addLobDataRecs); i++ {
 		to := (addLobDataRecs[i] + 1) * numColumn
 
 		r, err := c.exec(ctx, pr, nvargs[from:to], commit, ofs)
-		totalRowsAffected.add(r)
+		result.add(r)
 		if err != nil {
-			return driver.RowsAffected(totalRowsAffected), err
+			return result, err
 		}
 		from = to
 	}
-	return driver.RowsAffected(totalRowsAffected), nil
+	return result, nil
 }
@@ -0,0 +1,111 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// ParameterDescr describes a parameter of a prepared statement.
+type ParameterDescr struct {
+	Name             string // parameter name (empty for positional parameters of non procedure call statements)
+	DatabaseTypeName string // database type name (see sql.ColumnType.DatabaseTypeName)
+	Length           int64  // length of variable length types (e.g. NVARCHAR), 0 otherwise
+	Precision, Scale int64  // precision and scale of decimal types, 0 otherwise
+	Nullable         bool
+	In, Out          bool // parameter direction (both in case of an in,- output parameter)
+}
+
+// ColumnDescr describes a result column of a prepared statement.
+type ColumnDescr struct {
+	Name             string       // column (display) name
+	DatabaseTypeName string       // database type name (see sql.ColumnType.DatabaseTypeName)
+	ScanType         reflect.Type // go type suitable for scanning (see sql.ColumnType.ScanType)
+	Length           int64        // length of variable length types (e.g. NVARCHAR), 0 otherwise
+	Precision, Scale int64        // precision and scale of decimal types, 0 otherwise
+	Nullable         bool
+}
+
+// StmtMetadata provides the parameter and result column descriptors of a prepared statement.
+type StmtMetadata struct {
+	Parameters []ParameterDescr
+	Columns    []ColumnDescr
+}
+
+// NumInput returns the number of input (in and in,- output) parameters.
+func (m *StmtMetadata) NumInput() int {
+	numInput := 0
+	for _, prm := range m.Parameters {
+		if prm.In {
+			numInput++
+		}
+	}
+	return numInput
+}
+
+func newParameterDescr(f *p.ParameterField) ParameterDescr {
+	length, _ := f.TypeLength()
+	precision, scale, _ := f.TypePrecisionScale()
+	return ParameterDescr{
+		Name:             f.Name(),
+		DatabaseTypeName: f.TypeName(),
+		Length:           length,
+		Precision:        precision,
+		Scale:            scale,
+		Nullable:         f.Nullable(),
+		In:               f.In(),
+		Out:              f.Out(),
+	}
+}
+
+func newColumnDescr(f *p.ResultField) ColumnDescr {
+	length, _ := f.TypeLength()
+	precision, scale, _ := f.TypePrecisionScale()
+	return ColumnDescr{
+		Name:             f.Name(),
+		DatabaseTypeName: f.TypeName(),
+		ScanType:         f.ScanType(),
+		Length:           length,
+		Precision:        precision,
+		Scale:            scale,
+		Nullable:         f.Nullable(),
+	}
+}
+
+func newStmtMetadata(pr *prepareResult) *StmtMetadata {
+	m := &StmtMetadata{
+		Parameters: make([]ParameterDescr, len(pr.parameterFields)),
+		Columns:    make([]ColumnDescr, len(pr.resultFields)),
+	}
+	for i, f := range pr.parameterFields {
+		m.Parameters[i] = newParameterDescr(f)
+	}
+	for i, f := range pr.resultFields {
+		m.Columns[i] = newColumnDescr(f)
+	}
+	return m
+}
+
+/*
+DescribeStmt prepares the statement query on connection sqlConn and returns the parameter and result column
+descriptors provided by the database server, e.g. to validate statement arguments before executing the statement.
+The prepared statement is closed before DescribeStmt returns.
+
+The metadata does not depend on the connection, so any connection of the pool can be used.
+*/
+func DescribeStmt(ctx context.Context, sqlConn *sql.Conn, query string) (*StmtMetadata, error) {
+	var m *StmtMetadata
+	err := rawConn(sqlConn, func(c *conn) error {
+		driverStmt, err := c.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer driverStmt.Close()
+
+		m = newStmtMetadata(driverStmt.(*stmt).pr)
+		return nil
+	})
+	return m, err
+}
@@ -0,0 +1,126 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func testDescribeStmtQuery(t *testing.T, conn *sql.Conn) {
+	ctx := context.Background()
+
+	table := RandomIdentifier("describeStmt_")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("create table %s (i integer not null, s nvarchar(20), d decimal(10,2))", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := DescribeStmt(ctx, conn, fmt.Sprintf("select i, s, d from %s where i = ? and s = ?", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m.Parameters) != 2 || m.NumInput() != 2 {
+		t.Fatalf("number of parameters %d input parameters %d - expected %d", len(m.Parameters), m.NumInput(), 2)
+	}
+	for _, prm := range m.Parameters {
+		if !prm.In || prm.Out {
+			t.Fatalf("parameter %v: input parameter expected", prm)
+		}
+	}
+	if m.Parameters[1].DatabaseTypeName != "NVARCHAR" || m.Parameters[1].Length != 20 {
+		t.Fatalf("parameter %v: NVARCHAR(20) expected", m.Parameters[1])
+	}
+
+	expected := []ColumnDescr{
+		{Name: "I", DatabaseTypeName: "INTEGER", Nullable: false},
+		{Name: "S", DatabaseTypeName: "NVARCHAR", Length: 20, Nullable: true},
+		{Name: "D", DatabaseTypeName: "DECIMAL", Precision: 10, Scale: 2, Nullable: true},
+	}
+	if len(m.Columns) != len(expected) {
+		t.Fatalf("number of columns %d - expected %d", len(m.Columns), len(expected))
+	}
+	for i, col := range m.Columns {
+		col.ScanType = nil
+		if col != expected[i] {
+			t.Fatalf("column %d: %v - expected %v", i, col, expected[i])
+		}
+	}
+	if m.Columns[0].ScanType == nil {
+		t.Fatal("column scan type expected")
+	}
+
+	// statement without parameters and result columns
+	m, err = DescribeStmt(ctx, conn, fmt.Sprintf("delete from %s", table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Parameters) != 0 || len(m.Columns) != 0 {
+		t.Fatalf("number of parameters %d columns %d - expected 0", len(m.Parameters), len(m.Columns))
+	}
+}
+
+func testDescribeStmtCall(t *testing.T, conn *sql.Conn) {
+	const procInOut = `create procedure %[1]s (in idata integer, inout iodata nvarchar(25), out odata nvarchar(25))
+language SQLSCRIPT as
+begin
+    odata := iodata;
+    iodata := to_nvarchar(idata);
+end
+`
+	ctx := context.Background()
+
+	proc := RandomIdentifier("procInOut_")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf(procInOut, proc)); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := DescribeStmt(ctx, conn, fmt.Sprintf("call %s(?, ?, ?)", proc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ParameterDescr{
+		{Name: "IDATA", DatabaseTypeName: "INTEGER", In: true},
+		{Name: "IODATA", DatabaseTypeName: "NVARCHAR", Length: 25, In: true, Out: true},
+		{Name: "ODATA", DatabaseTypeName: "NVARCHAR", Length: 25, Out: true},
+	}
+	if len(m.Parameters) != len(expected) {
+		t.Fatalf("number of parameters %d - expected %d", len(m.Parameters), len(expected))
+	}
+	for i, prm := range m.Parameters {
+		prm.Nullable = false // procedure parameter nullability is not relevant for the test
+		if prm != expected[i] {
+			t.Fatalf("parameter %d: %v - expected %v", i, prm, expected[i])
+		}
+	}
+	if m.NumInput() != 2 {
+		t.Fatalf("number of input parameters %d - expected %d", m.NumInput(), 2)
+	}
+}
+
+func TestDescribeStmt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fct  func(t *testing.T, conn *sql.Conn)
+	}{
+		{"query", testDescribeStmtQuery},
+		{"call", testDescribeStmtCall},
+	}
+
+	db := MT.DB()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn, err := db.Conn(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			test.fct(t, conn)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const tableArgPrefix = "#tablearg_"
+
+// TableColumn is a column of a table argument provided column-wise (see CreateTableArg).
+type TableColumn struct {
+	Name   string // column name
+	Type   string // sql datatype of the column (e.g. "nvarchar(20)")
+	Values any    // slice of column values
+}
+
+func (c TableColumn) def() string { return quoteSQLIdentifier(c.Name) + " " + c.Type }
+
+// tableArgColumns returns the table columns of a slice of structs (or pointers to structs).
+func tableArgColumns(rv reflect.Value) ([]TableColumn, error) {
+	rt := rv.Type().Elem()
+	isPtr := rt.Kind() == reflect.Ptr
+	if isPtr {
+		rt = rt.Elem()
+	}
+	tagger, hasTagger := reflect.New(rt).Interface().(Tagger)
+
+	var columns structColumns
+	for _, structField := range reflect.VisibleFields(rt) {
+		if !structField.IsExported() || (structField.Anonymous && structField.Type.Kind() == reflect.Struct) {
+			continue
+		}
+		fieldTag := structField.Tag
+		if hasTagger {
+			if tag, ok := tagger.Tag(structField.Name); ok {
+				fieldTag = reflect.StructTag(tag)
+			}
+		}
+		if column, ok := newStructColumn(structField.Name, structField.Type, structField.Index, fieldTag); ok {
+			columns = append(columns, column)
+		}
+	}
+
+	numRow := rv.Len()
+	values := make([][]any, len(columns))
+	tableColumns := make([]TableColumn, len(columns))
+	for j, column := range columns {
+		typ, err := column.Type()
+		if err != nil {
+			return nil, fmt.Errorf("struct field %s: %w", column.fieldName, err)
+		}
+		values[j] = make([]any, numRow)
+		tableColumns[j] = TableColumn{Name: column.Name(), Type: typ, Values: values[j]}
+	}
+	for i := 0; i < numRow; i++ {
+		row := rv.Index(i)
+		if isPtr {
+			if row.IsNil() {
+				return nil, fmt.Errorf("invalid nil row %d", i)
+			}
+			row = row.Elem()
+		}
+		for j, column := range columns {
+			values[j][i] = row.FieldByIndex(column.fieldIndex).Interface()
+		}
+	}
+	return tableColumns, nil
+}
+
+/*
+CreateTableArg creates a local temporary table on connection sqlConn, inserts rows into the table and
+returns the table name.
+
+The driver does not implement the protocol-level serialization of table-typed parameters, so rows cannot be
+bound to a table-typed parameter (see ErrTableBindNotSupported). CreateTableArg is a workaround: the name of
+the temporary table needs to be part of the statement text instead, e.g. as table-typed input argument
+of a procedure call:
+
+	name, err := driver.CreateTableArg(ctx, conn, rows)
+	...
+	defer driver.DropTableArg(ctx, conn, name)
+	_, err = conn.ExecContext(ctx, fmt.Sprintf("call myproc(%s, ?)", name), 42)
+
+The table rows are provided either as
+  - a slice of structs or pointers to structs, where each exported struct field is mapped to a table column
+    in the order of the fields: the column name and sql datatype can be set via the sql field tag
+    (e.g. `sql:"NAME,nvarchar(20)"`), otherwise the field name is used and the datatype is inferred from the field type.
+    Like for StructScanner fields tagged with "-" are ignored, or
+  - a slice of TableColumn, providing the table column-wise, where the values of all columns need to be of equal length.
+
+The columns need to match the columns of the table type of the procedure parameter. Column names are
+quoted as delimited identifiers and are therefore case sensitive (e.g. use upper case names to reference
+columns unquoted in the procedure body). Cell values are converted like any other statement argument,
+so a NULL value is provided by a nil value, a nil pointer or an invalid sql.Null[T] value.
+
+The temporary table is bound to the database session and is only visible on connection sqlConn, so
+the procedure needs to be called on the same connection. The table should be dropped after the
+call via DropTableArg.
+*/
+func CreateTableArg(ctx context.Context, sqlConn *sql.Conn, rows any) (Identifier, error) {
+	var columns []TableColumn
+	switch rows := rows.(type) {
+	case []TableColumn:
+		columns = rows
+	default:
+		if !isTableArg(rows) {
+			return "", fmt.Errorf("invalid table argument type %T - slice of structs or table columns expected", rows)
+		}
+		var err error
+		if columns, err = tableArgColumns(reflect.ValueOf(rows)); err != nil {
+			return "", err
+		}
+	}
+	if len(columns) == 0 {
+		return "", errors.New("invalid table argument - no columns")
+	}
+
+	defs := make([]string, len(columns))
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		defs[i] = column.def()
+		values[i] = column.Values
+	}
+
+	name := RandomIdentifier(tableArgPrefix)
+	if _, err := sqlConn.ExecContext(ctx, fmt.Sprintf("create local temporary table %s (%s)", name, strings.Join(defs, ","))); err != nil {
+		return "", err
+	}
+	if err := insertTableArg(ctx, sqlConn, name, values); err != nil {
+		DropTableArg(ctx, sqlConn, name) //nolint:errcheck
+		return "", err
+	}
+	return name, nil
+}
+
+func insertTableArg(ctx context.Context, sqlConn *sql.Conn, name Identifier, values []any) error {
+	placeholders := strings.Repeat(",?", len(values))[1:]
+	stmt, err := sqlConn.PrepareContext(ctx, fmt.Sprintf("insert into %s values (%s)", name, placeholders))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.ExecContext(ctx, columnBatch(values))
+	return err
+}
+
+// DropTableArg drops the local temporary table name created by CreateTableArg.
+func DropTableArg(ctx context.Context, sqlConn *sql.Conn, name Identifier) error {
+	_, err := sqlConn.ExecContext(ctx, fmt.Sprintf("drop table %s", name))
+	return err
+}
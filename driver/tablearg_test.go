@@ -0,0 +1,144 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+const (
+	tableArgType = "create type %s as table (i integer, s nvarchar(20))"
+	tableArgProc = `create procedure %[1]s (in t %[2]s, out numrow integer, out numnull integer)
+language SQLSCRIPT as
+begin
+    select count(*) into numrow from :t;
+    select count(*) into numnull from :t where s is null;
+end
+`
+)
+
+// createTableArgProc creates a procedure with a table-typed input parameter and returns the procedure name.
+func createTableArgProc(t *testing.T, conn *sql.Conn) Identifier {
+	ctx := context.Background()
+
+	typ := RandomIdentifier("tableType_")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf(tableArgType, typ)); err != nil {
+		t.Fatal(err)
+	}
+	proc := RandomIdentifier("procTable_")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf(tableArgProc, proc, typ)); err != nil {
+		t.Fatal(err)
+	}
+	return proc
+}
+
+func testTableArgCall(t *testing.T, conn *sql.Conn, rows any, numRow, numNull int) {
+	ctx := context.Background()
+
+	proc := createTableArgProc(t, conn)
+
+	name, err := CreateTableArg(ctx, conn, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rowCount, nullCount int
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("call %s(%s, ?, ?)", proc, name), sql.Out{Dest: &rowCount}, sql.Out{Dest: &nullCount}); err != nil {
+		t.Fatal(err)
+	}
+	if rowCount != numRow || nullCount != numNull {
+		t.Fatalf("rows %d null values %d - expected %d %d", rowCount, nullCount, numRow, numNull)
+	}
+
+	if err := DropTableArg(ctx, conn, name); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testTableArgStruct(t *testing.T, conn *sql.Conn) {
+	type row struct {
+		I int     `sql:"I,integer"`
+		S *string `sql:"S,nvarchar(20)"`
+		X string  `sql:"-"`
+	}
+	s := "text"
+	rows := []row{{1, &s, "ignored"}, {2, nil, ""}, {3, &s, ""}}
+	testTableArgCall(t, conn, rows, len(rows), 1)
+}
+
+func testTableArgColumns(t *testing.T, conn *sql.Conn) {
+	columns := []TableColumn{
+		{Name: "I", Type: "integer", Values: []int{1, 2, 3, 4}},
+		{Name: "S", Type: "nvarchar(20)", Values: []any{"a", nil, sql.NullString{}, "d"}},
+	}
+	testTableArgCall(t, conn, columns, 4, 2)
+}
+
+func testTableArgColumnName(t *testing.T, conn *sql.Conn) {
+	ctx := context.Background()
+
+	// column names are delimited identifiers (case sensitive, embedded double quotes)
+	columns := []TableColumn{{Name: `Col"1`, Type: "integer", Values: []int{1, 2}}}
+	name, err := CreateTableArg(ctx, conn, columns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DropTableArg(ctx, conn, name) //nolint:errcheck
+
+	var sum int
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf(`select sum("Col""1") from %s`, name)).Scan(&sum); err != nil {
+		t.Fatal(err)
+	}
+	if sum != 3 {
+		t.Fatalf("sum %d - expected %d", sum, 3)
+	}
+}
+
+func testTableArgInvalid(t *testing.T, conn *sql.Conn) {
+	ctx := context.Background()
+
+	if _, err := CreateTableArg(ctx, conn, []int{1, 2}); err == nil {
+		t.Fatal("invalid table argument error expected")
+	}
+
+	// bind slice of structs to table-typed procedure input parameter
+	proc := createTableArgProc(t, conn)
+	type row struct {
+		I int
+		S string
+	}
+	var rowCount, nullCount int
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("call %s(?, ?, ?)", proc), []row{{1, "a"}}, sql.Out{Dest: &rowCount}, sql.Out{Dest: &nullCount}); !errors.Is(err, ErrTableBindNotSupported) {
+		t.Fatalf("got error %v - expected %v", err, ErrTableBindNotSupported)
+	}
+}
+
+func TestTableArg(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fct  func(t *testing.T, conn *sql.Conn)
+	}{
+		{"struct", testTableArgStruct},
+		{"columns", testTableArgColumns},
+		{"columnName", testTableArgColumnName},
+		{"invalid", testTableArgInvalid},
+	}
+
+	db := MT.DB()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn, err := db.Conn(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			test.fct(t, conn)
+		})
+	}
+}
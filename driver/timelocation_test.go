@@ -0,0 +1,53 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTimeLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	tableName := RandomIdentifier("timeLocation_")
+
+	connector := MT.NewConnector()
+	connector.SetTimeLocation(loc)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (ts timestamp, sd seconddate, d date)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	v := time.Date(2024, 2, 29, 23, 13, 14, 0, time.UTC)
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?, ?)", tableName), v, v, v); err != nil {
+		t.Fatal(err)
+	}
+
+	// stored wall clock time in loc
+	var ts, sd, d string
+	if err := db.QueryRow(fmt.Sprintf("select to_varchar(ts, 'YYYY-MM-DD HH24:MI:SS'), to_varchar(sd, 'YYYY-MM-DD HH24:MI:SS'), to_varchar(d, 'YYYY-MM-DD') from %s", tableName)).Scan(&ts, &sd, &d); err != nil {
+		t.Fatal(err)
+	}
+	if ts != "2024-03-01 01:13:14" || sd != "2024-03-01 01:13:14" || d != "2024-03-01" {
+		t.Fatalf("stored values %s %s %s - expected wall clock time in %s", ts, sd, d, loc)
+	}
+
+	// scanned values in loc
+	var tsv, sdv, dv time.Time
+	if err := db.QueryRow(fmt.Sprintf("select ts, sd, d from %s", tableName)).Scan(&tsv, &sdv, &dv); err != nil {
+		t.Fatal(err)
+	}
+	for _, tv := range []time.Time{tsv, sdv} {
+		if !tv.Equal(v) || tv.Location() != loc {
+			t.Fatalf("value %v - expected %v", tv, v.In(loc))
+		}
+	}
+	if expected := time.Date(2024, 3, 1, 0, 0, 0, 0, loc); !dv.Equal(expected) {
+		t.Fatalf("date value %v - expected %v", dv, expected)
+	}
+}
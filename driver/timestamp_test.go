@@ -0,0 +1,128 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestTimestampPrecision tests that stored and function derived timestamps are decoded with the same precision.
+func TestTimestampPrecision(t *testing.T) {
+	t.Parallel()
+
+	db := MT.DB()
+
+	tableName := RandomIdentifier("timestamp_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, t timestamp)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	// hdb timestamp precision: 100 nanoseconds
+	in := time.Date(2024, time.March, 1, 12, 13, 14, 123456700, time.UTC)
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", tableName), 1, in); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s select 2, current_timestamp from dummy", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	// stored timestamp vs function derived timestamps
+	var stored, added, converted time.Time
+	if err := db.QueryRow(fmt.Sprintf("select t, add_seconds(t, 0), to_timestamp(to_varchar(t, 'YYYY-MM-DD HH24:MI:SS.FF7')) from %s where i = 1", tableName)).Scan(&stored, &added, &converted); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []time.Time{stored, added, converted} {
+		if !v.Equal(in) {
+			t.Fatalf("got %s - expected %s", v, in)
+		}
+	}
+
+	// current_timestamp vs stored current_timestamp
+	rows, err := db.Query(fmt.Sprintf("select t, current_timestamp from %s where i = 2", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columnTypes[0].DatabaseTypeName() != columnTypes[1].DatabaseTypeName() {
+		t.Fatalf("different database types %s - %s", columnTypes[0].DatabaseTypeName(), columnTypes[1].DatabaseTypeName())
+	}
+	if !rows.Next() {
+		t.Fatal(sql.ErrNoRows)
+	}
+	var storedCurrent, current time.Time
+	if err := rows.Scan(&storedCurrent, &current); err != nil {
+		t.Fatal(err)
+	}
+	// both values are decoded with full fractional seconds
+	for _, v := range []time.Time{storedCurrent, current} {
+		if v.Nanosecond()%100 != 0 {
+			t.Fatalf("invalid precision of %s", v)
+		}
+	}
+	if current.Before(storedCurrent) {
+		t.Fatalf("current timestamp %s before stored current timestamp %s", current, storedCurrent)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestTimestampBindPrecision tests that bound time values are truncated to the precision of the column.
+func TestTimestampBindPrecision(t *testing.T) {
+	t.Parallel()
+
+	db := MT.DB()
+
+	tableName := RandomIdentifier("timestampBind_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i integer, ts timestamp, sd seconddate)", tableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	in := time.Date(2024, time.March, 1, 12, 13, 14, 123456789, time.UTC)
+	stmt, err := db.Prepare(fmt.Sprintf("insert into %s values (?, ?, ?)", tableName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+	// single value and bulk insert
+	if _, err := stmt.Exec(1, in, in); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stmt.Exec([][]any{{2, in, in}, {3, in, in}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the same truncation is applied to query parameters, so that all rows are selected
+	rows, err := db.Query(fmt.Sprintf("select ts, sd from %s where ts = ? and sd = ? order by i", tableName), in, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	numRow := 0
+	for rows.Next() {
+		var ts, sd time.Time
+		if err := rows.Scan(&ts, &sd); err != nil {
+			t.Fatal(err)
+		}
+		if expected := in.Truncate(100 * time.Nanosecond); !ts.Equal(expected) {
+			t.Fatalf("timestamp %s - expected %s", ts, expected)
+		}
+		if expected := in.Truncate(time.Second); !sd.Equal(expected) {
+			t.Fatalf("seconddate %s - expected %s", sd, expected)
+		}
+		numRow++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if numRow != 3 {
+		t.Fatalf("number of rows %d - expected %d", numRow, 3)
+	}
+}
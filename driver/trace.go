@@ -0,0 +1,98 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	p "github.com/SAP/go-hdb/driver/internal/protocol"
+)
+
+// Trace span names (operations).
+const (
+	SpanQuery   = "query"
+	SpanExec    = "exec"
+	SpanPrepare = "prepare"
+	SpanCall    = "call"
+)
+
+// Trace attribute keys.
+const (
+	TraceAttrOperation     = "db.operation.name"       // operation (see Span constants)
+	TraceAttrQuery         = "db.query.text"           // sql statement (without parameter values)
+	TraceAttrStatementType = "db.hdb.statement_type"   // statement type (e.g. SELECT, INSERT, DDL, CALL)
+	TraceAttrNumParameter  = "db.hdb.parameter_count"  // number of statement parameters
+	TraceAttrRowsAffected  = "db.hdb.rows_affected"    // number of rows affected
+	TraceAttrErrorCode     = "db.response.status_code" // database error code
+)
+
+// TraceAttr is a trace span attribute. Value is either of type string or int64.
+type TraceAttr struct {
+	Key   string
+	Value any
+}
+
+// Span is the interface of a trace span started by a Tracer.
+type Span interface {
+	// SetAttributes sets attributes of the span.
+	SetAttributes(attrs ...TraceAttr)
+	// RecordError records err as error of the span.
+	RecordError(err error)
+	// End ends the span.
+	End()
+}
+
+/*
+Tracer is the interface to create trace spans in a distributed tracing system like OpenTelemetry (see SetTracer).
+A span is started for each query, exec, prepare and procedure call, wrapping the database server
+request and reply cycle. The span is started with the context of the caller, so that spans nest correctly
+into the trace of the application.
+
+Span attributes are limited to the Trace attribute keys: parameter values are never recorded.
+
+An OpenTelemetry adapter would start the span via a trace.Tracer and map the attributes to attribute.String
+or attribute.Int64 values dependent on the value type.
+*/
+type Tracer interface {
+	// Start starts a span with name and attributes attrs and returns the span and a context containing the span.
+	Start(ctx context.Context, name string, attrs ...TraceAttr) (context.Context, Span)
+}
+
+/*
+traced executes fn within a span of operation op in case a tracer is set.
+In case pr is nil (direct execution) the statement type is taken from the database server reply.
+*/
+func traced[T any](ctx context.Context, c *conn, op, query string, pr *prepareResult, fn func(ctx context.Context) (T, error)) (T, error) {
+	attrs := []TraceAttr{{Key: TraceAttrOperation, Value: op}, {Key: TraceAttrQuery, Value: query}}
+	if pr != nil {
+		attrs = append(attrs, TraceAttr{Key: TraceAttrNumParameter, Value: int64(pr.numField())})
+	}
+	ctx, span := c.attrs._tracer.Start(ctx, op, attrs...)
+	defer span.End()
+
+	v, err := fn(ctx)
+
+	if pr == nil {
+		span.SetAttributes(TraceAttr{Key: TraceAttrStatementType, Value: c.pr.FunctionCode().StatementType()})
+	} else {
+		span.SetAttributes(TraceAttr{Key: TraceAttrStatementType, Value: pr.fc.StatementType()})
+	}
+	switch v := any(v).(type) {
+	case *prepareResult:
+		if v != nil {
+			span.SetAttributes(TraceAttr{Key: TraceAttrNumParameter, Value: int64(v.numField())})
+		}
+	case driver.Result:
+		if n, err := v.RowsAffected(); err == nil {
+			span.SetAttributes(TraceAttr{Key: TraceAttrRowsAffected, Value: n})
+		}
+	}
+	if err != nil {
+		var hdbErrors *p.HdbErrors
+		if errors.As(err, &hdbErrors) {
+			span.SetAttributes(TraceAttr{Key: TraceAttrErrorCode, Value: int64(hdbErrors.Code())})
+		}
+		span.RecordError(err)
+	}
+	return v, err
+}
@@ -0,0 +1,122 @@
+//go:build !unit
+
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]any
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...TraceAttr) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+func (s *testSpan) RecordError(err error) { s.err = err }
+func (s *testSpan) End()                  { s.ended = true }
+
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attrs ...TraceAttr) (context.Context, Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attrs: map[string]any{}}
+	span.SetAttributes(attrs...)
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func (t *testTracer) reset() []*testSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	spans := t.spans
+	t.spans = nil
+	return spans
+}
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+
+	const secret = "secret value"
+
+	tracer := &testTracer{}
+	connector := MT.NewConnector()
+	connector.SetTracer(tracer)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	table := RandomIdentifier("tracer_")
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("create table %s (i integer, s nvarchar(20))", table)); err != nil {
+		t.Fatal(err)
+	}
+	tracer.reset()
+
+	// nested spans: application span as parent
+	appCtx, appSpan := tracer.Start(ctx, "app")
+	insert := fmt.Sprintf("insert into %s values (?, ?)", table)
+	if _, err := conn.ExecContext(appCtx, insert, 1, secret); err != nil {
+		t.Fatal(err)
+	}
+	spans := tracer.reset()
+
+	checkSpan := func(span *testSpan, name string, attrs map[string]any) {
+		t.Helper()
+		if span.name != name || !span.ended || span.parent != appSpan {
+			t.Fatalf("span %s ended %t parent %v - expected ended span %s with application parent span", span.name, span.ended, span.parent, name)
+		}
+		for k, v := range attrs {
+			if span.attrs[k] != v {
+				t.Fatalf("span %s attribute %s: got %v - expected %v", name, k, span.attrs[k], v)
+			}
+		}
+		for k, v := range span.attrs {
+			if v == secret {
+				t.Fatalf("span %s attribute %s: parameter value recorded", name, k)
+			}
+		}
+	}
+
+	if len(spans) != 3 { // app, prepare, exec
+		t.Fatalf("number of spans %d - expected %d", len(spans), 3)
+	}
+	checkSpan(spans[1], SpanPrepare, map[string]any{TraceAttrQuery: insert, TraceAttrStatementType: "INSERT", TraceAttrNumParameter: int64(2)})
+	checkSpan(spans[2], SpanExec, map[string]any{TraceAttrQuery: insert, TraceAttrStatementType: "INSERT", TraceAttrNumParameter: int64(2), TraceAttrRowsAffected: int64(1)})
+
+	// error
+	query := fmt.Sprintf("select * from %s", RandomIdentifier("unknown_"))
+	if _, err := conn.QueryContext(appCtx, query); err == nil {
+		t.Fatal("database error expected")
+	}
+	spans = tracer.reset()
+	if len(spans) != 1 {
+		t.Fatalf("number of spans %d - expected %d", len(spans), 1)
+	}
+	checkSpan(spans[0], SpanQuery, map[string]any{TraceAttrQuery: query})
+	if spans[0].err == nil || spans[0].attrs[TraceAttrErrorCode] == nil {
+		t.Fatalf("span %s: error and error code expected", spans[0].name)
+	}
+}
@@ -0,0 +1,57 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func testTrimChar(t *testing.T, tableName Identifier, trimChar bool) {
+	connector := MT.NewConnector()
+	connector.SetTrimChar(trimChar)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	var c, vc string
+	if err := db.QueryRow(fmt.Sprintf("select c, vc from %s", tableName)).Scan(&c, &vc); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedChar := "go-hdb    " // char(10): padded with blanks
+	if trimChar {
+		expectedChar = "go-hdb"
+	}
+	if c != expectedChar {
+		t.Fatalf("char value %q - expected %q", c, expectedChar)
+	}
+	// varchar values are never trimmed
+	if vc != "go-hdb  " {
+		t.Fatalf("varchar value %q - expected %q", vc, "go-hdb  ")
+	}
+}
+
+func TestTrimChar(t *testing.T) {
+	t.Parallel()
+
+	tableName := RandomIdentifier("trimChar_")
+
+	db := MT.DB()
+
+	if _, err := db.Exec(fmt.Sprintf("create table %s (c char(10), vc varchar(10))", tableName)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", tableName), "go-hdb", "go-hdb  "); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, trimChar := range []bool{false, true} {
+		trimChar := trimChar // new trimChar to run in parallel
+
+		t.Run(fmt.Sprintf("trimChar %t", trimChar), func(t *testing.T) {
+			t.Parallel()
+			testTrimChar(t, tableName, trimChar)
+		})
+	}
+}
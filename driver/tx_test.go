@@ -3,6 +3,7 @@
 package driver_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -109,6 +110,58 @@ func testTransactionRollback(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testTransactionAutocommitQuery(t *testing.T, db *sql.DB) {
+	table := driver.RandomIdentifier("testTxAutocommitQuery_")
+	if _, err := db.Exec(fmt.Sprintf("create table %s (i tinyint)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if _, err := tx.Exec(fmt.Sprintf("insert into %s values(42)", table)); err != nil {
+		t.Fatal(err)
+	}
+
+	count := func(ctx context.Context, q interface {
+		QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	}) int {
+		i := 0
+		if err := q.QueryRowContext(ctx, fmt.Sprintf("select count(*) from %s", table)).Scan(&i); err != nil {
+			t.Fatal(err)
+		}
+		return i
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// transaction queries see the uncommitted record independent of the context
+	for _, ctx := range []context.Context{context.Background(), ctx} {
+		if i := count(ctx, tx); i != 1 {
+			t.Fatalf("tx: invalid number of records %d - 1 expected", i)
+		}
+	}
+	// autocommit queries (non-cancelable and cancelable context) do not see the uncommitted record
+	for _, ctx := range []context.Context{context.Background(), ctx} {
+		if i := count(ctx, db); i != 0 {
+			t.Fatalf("autocommit: invalid number of records %d - 0 expected", i)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	for _, ctx := range []context.Context{context.Background(), ctx} {
+		if i := count(ctx, db); i != 1 {
+			t.Fatalf("autocommit: invalid number of records %d - 1 expected", i)
+		}
+	}
+}
+
 func TestTransaction(t *testing.T) {
 	tests := []struct {
 		name string
@@ -116,6 +169,7 @@ func TestTransaction(t *testing.T) {
 	}{
 		{"transactionCommit", testTransactionCommit},
 		{"transactionRollback", testTransactionRollback},
+		{"transactionAutocommitQuery", testTransactionAutocommitQuery},
 	}
 
 	db := driver.MT.DB()
@@ -0,0 +1,98 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+)
+
+/*
+A UUID is the driver representation of a universally unique identifier (RFC 4122) stored either
+as character value in canonical form (e.g. VARCHAR(36)) or as binary value (BINARY(16)).
+
+UUID binds to character parameters as canonical string (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx) and to
+binary parameters as 16 byte value. Scanning accepts both representations, validating the canonical form
+of character values.
+
+As UUID is a [16]byte type, UUIDs of other packages like github.com/google/uuid can be converted without the
+driver depending on them:
+
+	var id driver.UUID
+	db.QueryRow("select id from t").Scan(&id)
+	u := uuid.UUID(id)
+	db.Exec("insert into t values (?)", driver.UUID(u))
+*/
+type UUID [16]byte
+
+const (
+	uuidStrLen = 36
+	uuidBinLen = 16
+)
+
+var uuidHyphenPos = [...]int{8, 13, 18, 23}
+
+// ParseUUID parses s in canonical form (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, case insensitive) and returns the UUID.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	if len(s) != uuidStrLen {
+		return u, fmt.Errorf("uuid: invalid length %d of %q - expected %d", len(s), s, uuidStrLen)
+	}
+	for _, pos := range uuidHyphenPos {
+		if s[pos] != '-' {
+			return u, fmt.Errorf("uuid: invalid format %q - hyphen expected at position %d", s, pos)
+		}
+	}
+	src := []byte(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:])
+	if _, err := hex.Decode(u[:], src); err != nil {
+		return u, fmt.Errorf("uuid: invalid format %q: %w", s, err)
+	}
+	return u, nil
+}
+
+// String returns the canonical (lower case) string representation of u.
+func (u UUID) String() string {
+	var b [uuidStrLen]byte
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+	return string(b[:])
+}
+
+// Scan implements the database/sql/Scanner interface.
+func (u *UUID) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return u.parse(src)
+	case []byte:
+		switch len(src) {
+		case uuidBinLen:
+			copy(u[:], src)
+			return nil
+		case uuidStrLen:
+			return u.parse(string(src))
+		default:
+			return fmt.Errorf("uuid: invalid length %d - expected %d (binary) or %d (canonical form)", len(src), uuidBinLen, uuidStrLen)
+		}
+	default:
+		return fmt.Errorf("uuid: invalid data type %T", src)
+	}
+}
+
+func (u *UUID) parse(s string) error {
+	v, err := ParseUUID(s)
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// Value implements the database/sql/Valuer interface.
+// The canonical form is returned, binary parameters are bound as 16 byte value by the driver.
+func (u UUID) Value() (driver.Value, error) { return u.String(), nil }
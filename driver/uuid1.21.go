@@ -0,0 +1,8 @@
+//go:build !go1.22
+
+package driver
+
+import "database/sql/driver"
+
+// nullUUIDArg is a no-op as sql.Null[T] is not available before go1.22.
+func nullUUIDArg(arg driver.Value) (driver.Value, bool) { return arg, false }
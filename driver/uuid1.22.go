@@ -0,0 +1,26 @@
+//go:build go1.22
+
+package driver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// nullUUIDArg returns the binary representation of a nullable UUID arg (null values are returned as nil).
+func nullUUIDArg(arg driver.Value) (driver.Value, bool) {
+	switch arg := arg.(type) {
+	case sql.Null[UUID]:
+		if !arg.Valid {
+			return nil, true
+		}
+		return arg.V[:], true
+	case *sql.Null[UUID]:
+		if arg == nil || !arg.Valid {
+			return nil, true
+		}
+		return arg.V[:], true
+	default:
+		return arg, false
+	}
+}
@@ -0,0 +1,138 @@
+//go:build !unit
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func testUUIDParse(t *testing.T, db *sql.DB) {
+	const s = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	for _, src := range []string{s, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"} {
+		u, err := ParseUUID(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.String() != s {
+			t.Fatalf("%s: got %s - expected %s", src, u, s)
+		}
+	}
+
+	for _, src := range []any{
+		"",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c",   // too short
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8a", // too long
+		"6ba7b8109-dad-11d1-80b4-00c04fd430c8",  // misplaced hyphen
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cx",  // invalid hex digit
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430}",  // non canonical
+		[]byte{0x01, 0x02},
+		nil,
+		int64(42),
+	} {
+		var u UUID
+		if err := u.Scan(src); err == nil {
+			t.Fatalf("%v: error expected", src)
+		}
+	}
+}
+
+func testUUIDRoundtrip(t *testing.T, db *sql.DB) {
+	uuids := []UUID{
+		{},
+		{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+
+	for _, columnType := range []string{"varchar(36)", "binary(16)"} {
+		t.Run(columnType, func(t *testing.T) {
+			table := RandomIdentifier("uuid_")
+			if _, err := db.Exec(fmt.Sprintf("create column table %s (i integer, u %s)", table, columnType)); err != nil {
+				t.Fatal(err)
+			}
+			for i, u := range uuids {
+				if _, err := db.Exec(fmt.Sprintf("insert into %s values (?, ?)", table), i, u); err != nil {
+					t.Fatal(err)
+				}
+			}
+			rows, err := db.Query(fmt.Sprintf("select u from %s order by i", table))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			i := 0
+			for rows.Next() {
+				var u UUID
+				if err := rows.Scan(&u); err != nil {
+					t.Fatal(err)
+				}
+				if u != uuids[i] {
+					t.Fatalf("row %d: got %s - expected %s", i, u, uuids[i])
+				}
+				i++
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if i != len(uuids) {
+				t.Fatalf("number of rows %d - expected %d", i, len(uuids))
+			}
+		})
+	}
+
+	// UUID pointer bound as binary value
+	table := RandomIdentifier("uuid_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (u binary(16))", table)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", table), &uuids[1]); err != nil {
+		t.Fatal(err)
+	}
+	var u UUID
+	if err := db.QueryRow(fmt.Sprintf("select u from %s", table)).Scan(&u); err != nil {
+		t.Fatal(err)
+	}
+	if u != uuids[1] {
+		t.Fatalf("value %s - expected %s", u, uuids[1])
+	}
+
+	// canonical form stored as character value
+	table = RandomIdentifier("uuid_")
+	if _, err := db.Exec(fmt.Sprintf("create column table %s (u varchar(36))", table)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(fmt.Sprintf("insert into %s values (?)", table), uuids[1]); err != nil {
+		t.Fatal(err)
+	}
+	var s string
+	if err := db.QueryRow(fmt.Sprintf("select u from %s", table)).Scan(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s != uuids[1].String() {
+		t.Fatalf("value %s - expected %s", s, uuids[1])
+	}
+}
+
+func TestUUID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fct  func(t *testing.T, db *sql.DB)
+	}{
+		{"parse", testUUIDParse},
+		{"roundtrip", testUUIDRoundtrip},
+	}
+
+	db := MT.DB()
+	for _, test := range tests {
+		test := test // new test to run in parallel
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			test.fct(t, db)
+		})
+	}
+}